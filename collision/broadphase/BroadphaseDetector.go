@@ -13,9 +13,10 @@ type BroadphaseDetector interface {
 	Update(collidable collision.Collider)
 	Clear()
 	GetAABB(collidable collision.Collider) *geometry.AABB
+	Detect() []*BroadphasePair
 	DetectAABB(aabb *geometry.AABB) []collision.Collider
 	Raycast(ray *geometry.Ray, length float64) []collision.Collider
-	Detect(a, b interface{}) bool
+	DetectColliders(a, b collision.Collider) bool
 	DetectConvexTransform(convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform) bool
 	GetAABBExpansion() float64
 	SetAABBExpansion(expansion float64)
@@ -25,6 +25,8 @@ type DynamicAABBTree struct {
 	proxyMap  map[string]*DATNode
 }
 
+var _ BroadphaseDetector = new(DynamicAABBTree)
+
 func NewDynamicAABBTree() *DynamicAABBTree {
 	return NewDynamicAABBTreeInt(64)
 }
@@ -364,7 +366,7 @@ func (d *DynamicAABBTree) remove(node *DATNode) {
 		other.parent = grandparent
 		n := grandparent
 		for n != nil {
-			n := d.balance(n)
+			n = d.balance(n)
 			left := n.left
 			right := n.right
 			n.height = 1 + int(math.Max(float64(left.height), float64(right.height)))
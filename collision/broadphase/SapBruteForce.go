@@ -70,6 +70,8 @@ type SapBruteForce struct {
 	sort           bool
 }
 
+var _ BroadphaseDetector = new(SapBruteForce)
+
 func NewSapBruteForce() *SapBruteForce {
 	return NewSapBruteForceInt(64)
 }
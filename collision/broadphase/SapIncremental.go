@@ -80,6 +80,8 @@ type SapIncremental struct {
 	potentialPairs []*sapIncrementalPairList
 }
 
+var _ BroadphaseDetector = new(SapIncremental)
+
 func NewSapIncremental() *SapIncremental {
 	return NewSapIncrementalInt(64)
 }
@@ -120,6 +120,8 @@ type SapTree struct {
 	potentialPairs []*SapTreePairList
 }
 
+var _ BroadphaseDetector = new(SapTree)
+
 func NewSapTree() *SapTree {
 	return NewSapTreeInt(64)
 }
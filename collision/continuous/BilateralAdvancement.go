@@ -0,0 +1,250 @@
+package continuous
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/collision/narrowphase"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+const (
+	DEFAULT_MAX_ROOT_ITERATIONS      = 50
+	DEFAULT_MAX_PUSH_BACK_ITERATIONS = 8
+)
+
+const (
+	SEPARATION_FUNCTION_POINTS = iota
+	SEPARATION_FUNCTION_FACE1
+	SEPARATION_FUNCTION_FACE2
+)
+
+type BilateralAdvancement struct {
+	distanceDetector  narrowphase.DistanceDetector
+	distanceEpsilon   float64
+	maxIterations     int
+	maxRootIterations int
+}
+
+var _ TimeOfImpactDetector = new(BilateralAdvancement)
+
+func NewBilateralAdvancement(distanceDetector narrowphase.DistanceDetector) *BilateralAdvancement {
+	if distanceDetector == nil {
+		panic("Distance detector cannot be nil")
+	}
+	b := new(BilateralAdvancement)
+	b.distanceDetector = distanceDetector
+	b.distanceEpsilon = DEFAULT_DISTANCE_EPSILON
+	b.maxIterations = DEFAULT_MAX_ITERATIONS
+	b.maxRootIterations = DEFAULT_MAX_ROOT_ITERATIONS
+	return b
+}
+
+func (b *BilateralAdvancement) GetTimeOfImpact(convex1 geometry.Convexer, transform1 *geometry.Transform, dp1 *geometry.Vector2, da1 float64, convex2 geometry.Convexer, transform2 *geometry.Transform, dp2 *geometry.Vector2, da2 float64, toi *TimeOfImpact) bool {
+	return b.GetTimeOfImpactBounded(convex1, transform1, dp1, da1, convex2, transform2, dp2, da2, 0, 1, toi)
+}
+
+func (b *BilateralAdvancement) GetTimeOfImpactBounded(convex1 geometry.Convexer, transform1 *geometry.Transform, dp1 *geometry.Vector2, da1 float64, convex2 geometry.Convexer, transform2 *geometry.Transform, dp2 *geometry.Vector2, da2, t1, t2 float64, toi *TimeOfImpact) bool {
+	target := b.distanceEpsilon
+	tolerance := 0.25 * b.distanceEpsilon
+	f := newSeparationFunction(convex1, transform1, dp1, da1, convex2, transform2, dp2, da2)
+	separation := narrowphase.NewSeparation()
+	t := t1
+	for iterations := 0; iterations < b.maxIterations; iterations++ {
+		f.lerp(t)
+		if !b.distanceDetector.Distance(convex1, f.lerpTx1, convex2, f.lerpTx2, separation) {
+			return false
+		}
+		if separation.GetDistance() < target+tolerance {
+			toi.time = t
+			toi.separation = separation
+			return true
+		}
+		f.initialize(separation)
+		tMax := t2
+		advanced := false
+		for pushBack := 0; pushBack < DEFAULT_MAX_PUSH_BACK_ITERATIONS; pushBack++ {
+			s2, p1, p2 := f.findMinSeparation(tMax)
+			if s2 > target+tolerance {
+				return false
+			}
+			if s2 > target-tolerance {
+				t = tMax
+				advanced = true
+				break
+			}
+			s1 := f.evaluate(p1, p2, t)
+			if s1 < target-tolerance {
+				return false
+			}
+			if s1 <= target+tolerance {
+				toi.time = t
+				toi.separation = separation
+				return true
+			}
+			a1, a2 := t, tMax
+			for k := 0; k < b.maxRootIterations; k++ {
+				var r float64
+				if k&1 == 1 {
+					r = a1 + (target-s1)*(a2-a1)/(s2-s1)
+				} else {
+					r = 0.5 * (a1 + a2)
+				}
+				s := f.evaluate(p1, p2, r)
+				if math.Abs(s-target) < tolerance {
+					tMax = r
+					break
+				}
+				if s > target {
+					a1 = r
+					s1 = s
+				} else {
+					a2 = r
+					s2 = s
+				}
+			}
+		}
+		if !advanced {
+			break
+		}
+	}
+	f.lerp(t)
+	b.distanceDetector.Distance(convex1, f.lerpTx1, convex2, f.lerpTx2, separation)
+	toi.time = t
+	toi.separation = separation
+	return true
+}
+
+func (b *BilateralAdvancement) GetDistanceDetector() narrowphase.DistanceDetector {
+	return b.distanceDetector
+}
+
+func (b *BilateralAdvancement) SetDistanceDetector(distanceDetector narrowphase.DistanceDetector) {
+	if distanceDetector == nil {
+		panic("Distance detector cannot be nil")
+	}
+	b.distanceDetector = distanceDetector
+}
+
+func (b *BilateralAdvancement) GetDistanceEpsilon() float64 {
+	return b.distanceEpsilon
+}
+
+func (b *BilateralAdvancement) SetDistanceEpsilon(distanceEpsilon float64) {
+	if distanceEpsilon <= 0 {
+		panic("Distance epsilon must be strictly positive")
+	}
+	b.distanceEpsilon = distanceEpsilon
+}
+
+func (b *BilateralAdvancement) GetMaxIterations() int {
+	return b.maxIterations
+}
+
+func (b *BilateralAdvancement) SetMaxIterations(maxIterations int) {
+	if maxIterations < 5 {
+		panic("Max iterations cannot be less than 5")
+	}
+	b.maxIterations = maxIterations
+}
+
+func (b *BilateralAdvancement) GetMaxRootIterations() int {
+	return b.maxRootIterations
+}
+
+func (b *BilateralAdvancement) SetMaxRootIterations(maxRootIterations int) {
+	if maxRootIterations < 5 {
+		panic("Max root iterations cannot be less than 5")
+	}
+	b.maxRootIterations = maxRootIterations
+}
+
+type separationFunction struct {
+	convex1, convex2       geometry.Convexer
+	transform1, transform2 *geometry.Transform
+	dp1, dp2               *geometry.Vector2
+	da1, da2               float64
+	lerpTx1, lerpTx2       *geometry.Transform
+	functionType           int
+	axis                   *geometry.Vector2
+	localPoint             *geometry.Vector2
+}
+
+func newSeparationFunction(convex1 geometry.Convexer, transform1 *geometry.Transform, dp1 *geometry.Vector2, da1 float64, convex2 geometry.Convexer, transform2 *geometry.Transform, dp2 *geometry.Vector2, da2 float64) *separationFunction {
+	f := new(separationFunction)
+	f.convex1 = convex1
+	f.transform1 = transform1
+	f.dp1 = dp1
+	f.da1 = da1
+	f.convex2 = convex2
+	f.transform2 = transform2
+	f.dp2 = dp2
+	f.da2 = da2
+	f.lerpTx1 = geometry.NewTransform()
+	f.lerpTx2 = geometry.NewTransform()
+	return f
+}
+
+func (f *separationFunction) lerp(t float64) {
+	f.transform1.LerpDeltaInDestination(f.dp1, f.da1, t, f.lerpTx1)
+	f.transform2.LerpDeltaInDestination(f.dp2, f.da2, t, f.lerpTx2)
+}
+
+func (f *separationFunction) initialize(separation *narrowphase.Separation) {
+	n := separation.GetNormal()
+	if isFace(f.convex1.GetFarthestFeature(n, f.lerpTx1), n) {
+		f.functionType = SEPARATION_FUNCTION_FACE1
+		f.axis = f.lerpTx1.GetInverseTransformedR(n)
+		f.localPoint = f.lerpTx1.GetInverseTransformedVector2(separation.GetPoint1())
+	} else if isFace(f.convex2.GetFarthestFeature(n.GetNegative(), f.lerpTx2), n) {
+		f.functionType = SEPARATION_FUNCTION_FACE2
+		f.axis = f.lerpTx2.GetInverseTransformedR(n.GetNegative())
+		f.localPoint = f.lerpTx2.GetInverseTransformedVector2(separation.GetPoint2())
+	} else {
+		f.functionType = SEPARATION_FUNCTION_POINTS
+		f.axis = geometry.NewVector2FromVector2(n)
+		f.localPoint = nil
+	}
+}
+
+func isFace(feature geometry.Featurer, n *geometry.Vector2) bool {
+	edge, ok := feature.(*geometry.Edge)
+	if !ok {
+		return false
+	}
+	e := edge.GetEdge().GetNormalized()
+	return math.Abs(e.DotVector2(n)) < 1.0e-3
+}
+
+func (f *separationFunction) findMinSeparation(t float64) (float64, *geometry.Vector2, *geometry.Vector2) {
+	f.lerp(t)
+	switch f.functionType {
+	case SEPARATION_FUNCTION_FACE1:
+		axis := f.lerpTx1.GetTransformedR(f.axis)
+		p1 := f.lerpTx1.GetTransformedVector2(f.localPoint)
+		p2 := f.convex2.GetFarthestPoint(axis.GetNegative(), f.lerpTx2)
+		return p1.HereToVector2(p2).DotVector2(axis), f.localPoint, f.lerpTx2.GetInverseTransformedVector2(p2)
+	case SEPARATION_FUNCTION_FACE2:
+		axis := f.lerpTx2.GetTransformedR(f.axis)
+		p1 := f.convex1.GetFarthestPoint(axis.GetNegative(), f.lerpTx1)
+		p2 := f.lerpTx2.GetTransformedVector2(f.localPoint)
+		return p2.HereToVector2(p1).DotVector2(axis), f.lerpTx1.GetInverseTransformedVector2(p1), f.localPoint
+	default:
+		p1 := f.convex1.GetFarthestPoint(f.axis, f.lerpTx1)
+		p2 := f.convex2.GetFarthestPoint(f.axis.GetNegative(), f.lerpTx2)
+		return p1.HereToVector2(p2).DotVector2(f.axis), f.lerpTx1.GetInverseTransformedVector2(p1), f.lerpTx2.GetInverseTransformedVector2(p2)
+	}
+}
+
+func (f *separationFunction) evaluate(localPoint1, localPoint2 *geometry.Vector2, t float64) float64 {
+	f.lerp(t)
+	p1 := f.lerpTx1.GetTransformedVector2(localPoint1)
+	p2 := f.lerpTx2.GetTransformedVector2(localPoint2)
+	switch f.functionType {
+	case SEPARATION_FUNCTION_FACE1:
+		return p1.HereToVector2(p2).DotVector2(f.lerpTx1.GetTransformedR(f.axis))
+	case SEPARATION_FUNCTION_FACE2:
+		return p2.HereToVector2(p1).DotVector2(f.lerpTx2.GetTransformedR(f.axis))
+	default:
+		return p1.HereToVector2(p2).DotVector2(f.axis)
+	}
+}
@@ -1,24 +1,37 @@
 package continuous
 
 import (
+	"math"
+
 	"github.com/LSFN/dyn4go"
 	"github.com/LSFN/dyn4go/collision/narrowphase"
 	"github.com/LSFN/dyn4go/geometry"
-	"math"
+)
+
+const (
+	DEFAULT_MAX_ITERATIONS = 30
+)
+
+var (
+	DEFAULT_DISTANCE_EPSILON = math.Cbrt(dyn4go.Epsilon)
 )
 
 type ConservativeAdvancement struct {
-	distanceDetector *narrowphase.DistanceDetector
+	distanceDetector narrowphase.DistanceDetector
 	distanceEpsilon  float64
 	maxIterations    int
 }
 
-func NewConservativeAdvancement(distanceDetector *narrowphase.DistanceDetector) *narrowphase.DistanceDetector {
+var _ TimeOfImpactDetector = new(ConservativeAdvancement)
+
+func NewConservativeAdvancement(distanceDetector narrowphase.DistanceDetector) *ConservativeAdvancement {
 	if distanceDetector == nil {
 		panic("Distance detector cannot be nil")
 	}
 	c := new(ConservativeAdvancement)
 	c.distanceDetector = distanceDetector
+	c.distanceEpsilon = DEFAULT_DISTANCE_EPSILON
+	c.maxIterations = DEFAULT_MAX_ITERATIONS
 	return c
 }
 
@@ -74,18 +87,16 @@ func (c *ConservativeAdvancement) GetTimeOfImpactBounded(convex1 geometry.Convex
 		iterations++
 		transform1.LerpDeltaInDestination(dp1, da1, l, lerpTx1)
 		transform2.LerpDeltaInDestination(dp2, da2, l, lerpTx2)
-		separated := c.distanceDetector.Distance(convex1, lerpTx1, convex2, lerpTx2, separation)
-		d := separation.GetDistance()
+		separated = c.distanceDetector.Distance(convex1, lerpTx1, convex2, lerpTx2, separation)
+		d = separation.GetDistance()
 		if !separated {
 			l -= 0.5 * c.distanceEpsilon / drel
 			transform1.LerpDeltaInDestination(dp1, da1, l, lerpTx1)
 			transform2.LerpDeltaInDestination(dp2, da2, l, lerpTx2)
-			separated = c.distanceDetector.Distance(convex1, lerpTx1, convex2, lerpTx2, separation)
-			d = separation.GetDistance()
+			c.distanceDetector.Distance(convex1, lerpTx1, convex2, lerpTx2, separation)
 			break
 		}
 		n = separation.GetNormal()
-		d = separation.GetDistance()
 	}
 	toi.time = l
 	toi.separation = separation
@@ -109,7 +120,7 @@ func (c *ConservativeAdvancement) GetDistanceEpsilon() float64 {
 
 func (c *ConservativeAdvancement) SetDistanceEpsilon(distanceEpsilon float64) {
 	if distanceEpsilon <= 0 {
-		panic("Distance epsilong must be strictly positive")
+		panic("Distance epsilon must be strictly positive")
 	}
 	c.distanceEpsilon = distanceEpsilon
 }
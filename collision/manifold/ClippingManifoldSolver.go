@@ -8,6 +8,8 @@ import (
 
 type ClippingManifoldSolver struct{}
 
+var _ ManifoldSolver = new(ClippingManifoldSolver)
+
 func (c *ClippingManifoldSolver) GetManifold(penetration *narrowphase.Penetration, convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform, manifold *Manifold) bool {
 	manifold.Clear()
 	n := penetration.GetNormal()
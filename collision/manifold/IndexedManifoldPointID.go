@@ -28,3 +28,10 @@ func (i *IndexedManifoldPointId) GetIncidentVertex() int {
 func (i *IndexedManifoldPointId) IsFlipped() bool {
 	return i.flipped
 }
+
+func (i *IndexedManifoldPointId) Equals(other interface{}) bool {
+	if o, ok := other.(*IndexedManifoldPointId); ok && o != nil {
+		return *i == *o
+	}
+	return false
+}
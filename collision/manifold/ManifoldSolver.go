@@ -6,5 +6,5 @@ import (
 )
 
 type ManifoldSolver interface {
-	GetManifold(penetration *narrowphase.Penetration, convex1 *geometry.Convexer, transform1 *geometry.Transform, convex2 *geometry.Convexer, transform2 *geometry.Transform, manifold *Manifold) bool
+	GetManifold(penetration *narrowphase.Penetration, convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform, manifold *Manifold) bool
 }
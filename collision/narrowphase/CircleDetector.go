@@ -39,11 +39,11 @@ func DistanceCircle(circle1 *geometry.Circle, transform1 *geometry.Transform, ci
 	r2 := circle2.GetRadius()
 	radii := r1 + r2
 	mag := v.GetMagnitude()
-	if mag < radii {
+	if mag >= radii {
 		separation.normal = v
 		separation.distance = v.Normalize() - radii
 		separation.point1 = ce1.AddXY(v.X*r1, v.Y*r1)
-		separation.point2 = ce2.AddXY(v.X*r2, v.Y*r2)
+		separation.point2 = ce2.AddXY(-v.X*r2, -v.Y*r2)
 		return true
 	}
 	return false
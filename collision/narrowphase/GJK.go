@@ -78,7 +78,7 @@ func (g *GJK) detect(ms *MinkowskiSum, simplex *[]*geometry.Vector2, d *geometry
 		if (*simplex)[len(*simplex)-1].DotVector2(d) <= 0 {
 			return false
 		} else {
-			if g.checkSimplex(*simplex, d) {
+			if g.checkSimplex(simplex, d) {
 				return true
 			}
 		}
@@ -86,34 +86,35 @@ func (g *GJK) detect(ms *MinkowskiSum, simplex *[]*geometry.Vector2, d *geometry
 	return false
 }
 
-func (g *GJK) checkSimplex(simplex []*geometry.Vector2, direction *geometry.Vector2) bool {
-	a := simplex[len(simplex)-1]
+func (g *GJK) checkSimplex(simplex *[]*geometry.Vector2, direction *geometry.Vector2) bool {
+	s := *simplex
+	a := s[len(s)-1]
 	ao := a.GetNegative()
-	if len(simplex) == 3 {
-		b := simplex[1]
-		c := simplex[0]
+	if len(s) == 3 {
+		b := s[1]
+		c := s[0]
 		ab := a.HereToVector2(b)
 		ac := a.HereToVector2(c)
 		abPerp := geometry.Vector2TripleProduct(ac, ab, ab)
 		acPerp := geometry.Vector2TripleProduct(ab, ac, ac)
 		acLocation := acPerp.DotVector2(ao)
 		if acLocation >= 0 {
-			simplex[1] = simplex[2]
-			simplex[2] = nil
+			s[1] = s[2]
+			*simplex = s[:2]
 			direction.SetToVector2(acPerp)
 		} else {
 			abLocation := abPerp.DotVector2(ao)
 			if abLocation < 0 {
 				return true
 			} else {
-				simplex[0] = simplex[1]
-				simplex[1] = simplex[2]
-				simplex[2] = nil
+				s[0] = s[1]
+				s[1] = s[2]
+				*simplex = s[:2]
 				direction.SetToVector2(abPerp)
 			}
 		}
 	} else {
-		b := simplex[0]
+		b := s[0]
 		ab := a.HereToVector2(b)
 		direction.SetToVector2(geometry.Vector2TripleProduct(ab, ao, ab))
 		if direction.GetMagnitudeSquared() <= dyn4go.Epsilon {
@@ -226,6 +227,9 @@ func (g *GJK) Raycast(ray *geometry.Ray, maxLength float64, convex geometry.Conv
 	if reflect.TypeOf(convex) == reflect.TypeOf(new(geometry.Segment)) {
 		return RaycastSegment(ray, maxLength, convex.(*geometry.Segment), transform, raycast)
 	}
+	if reflect.TypeOf(convex) == reflect.TypeOf(new(geometry.Link)) {
+		return RaycastSegment(ray, maxLength, &convex.(*geometry.Link).Segment, transform, raycast)
+	}
 	λ := 0.0
 	lengthCheck := (maxLength > 0)
 	var a, b *geometry.Vector2
@@ -0,0 +1,55 @@
+package narrowphase
+
+import (
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type LinkPostProcessor struct{}
+
+var _ NarrowphasePostProcessor = new(LinkPostProcessor)
+
+func (l *LinkPostProcessor) Process(convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform, penetration *Penetration) bool {
+	if link, ok := convex1.(*geometry.Link); ok {
+		return l.correct(link, transform1, convex2, transform2, penetration, false)
+	}
+	if link, ok := convex2.(*geometry.Link); ok {
+		return l.correct(link, transform2, convex1, transform1, penetration, true)
+	}
+	return true
+}
+
+func (l *LinkPostProcessor) ProcessSeparation(convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform, separation *Separation) bool {
+	if link, ok := convex1.(*geometry.Link); ok {
+		return l.isOwnSeparation(link, transform1, separation.GetNormal())
+	}
+	if link, ok := convex2.(*geometry.Link); ok {
+		return l.isOwnSeparation(link, transform2, separation.GetNormal().GetNegative())
+	}
+	return true
+}
+
+func (l *LinkPostProcessor) correct(link *geometry.Link, linkTransform *geometry.Transform, convex geometry.Convexer, transform *geometry.Transform, penetration *Penetration, flipped bool) bool {
+	n := penetration.GetNormal()
+	if flipped {
+		n = n.GetNegative()
+	}
+	corrected := link.GetCorrectedNormal(n, linkTransform)
+	if corrected.DistanceSquaredFromVector2(n) <= dyn4go.Epsilon*dyn4go.Epsilon {
+		return true
+	}
+	depth := link.ProjectVector2Transform(corrected, linkTransform).GetMax() - convex.ProjectVector2Transform(corrected, transform).GetMin()
+	if depth <= 0 {
+		return false
+	}
+	if flipped {
+		corrected.Negate()
+	}
+	penetration.SetNormal(corrected)
+	penetration.SetDepth(depth)
+	return true
+}
+
+func (l *LinkPostProcessor) isOwnSeparation(link *geometry.Link, linkTransform *geometry.Transform, n *geometry.Vector2) bool {
+	return link.GetCorrectedNormal(n, linkTransform).DistanceSquaredFromVector2(n) <= dyn4go.Epsilon*dyn4go.Epsilon
+}
@@ -0,0 +1,10 @@
+package narrowphase
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type NarrowphasePostProcessor interface {
+	Process(convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform, penetration *Penetration) bool
+	ProcessSeparation(convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform, separation *Separation) bool
+}
@@ -19,10 +19,13 @@ func RaycastSegment(ray *geometry.Ray, maxLength float64, segment *geometry.Segm
 	num := d1.CrossVector2(p0ToP1)
 	den := d1.CrossVector2(d0)
 	if math.Abs(den) <= dyn4go.Epsilon {
+		if math.Abs(num) > dyn4go.Epsilon {
+			return false
+		}
 		d0DotP0 := d0.DotVector2(p0)
 		d0DotP1 := d0.DotVector2(p1)
 		d0DotP2 := d0.DotVector2(p2)
-		if d0DotP1 < 0 || d0DotP2 < 0 {
+		if d0DotP1 < d0DotP0 || d0DotP2 < d0DotP0 {
 			return false
 		}
 		d := 0.0
@@ -31,7 +34,7 @@ func RaycastSegment(ray *geometry.Ray, maxLength float64, segment *geometry.Segm
 			d = d0DotP1 - d0DotP0
 			p = geometry.NewVector2FromVector2(p1)
 		} else {
-			d = d0DotP1 - d0DotP0
+			d = d0DotP2 - d0DotP0
 			p = geometry.NewVector2FromVector2(p2)
 		}
 		if maxLength > 0 && d > maxLength {
@@ -41,8 +44,6 @@ func RaycastSegment(ray *geometry.Ray, maxLength float64, segment *geometry.Segm
 		raycast.point = p
 		raycast.normal = d0.GetNegative()
 		return true
-	} else {
-		return false
 	}
 	t := num / den
 	if t < 0 {
@@ -51,7 +52,12 @@ func RaycastSegment(ray *geometry.Ray, maxLength float64, segment *geometry.Segm
 	if maxLength > 0 && t > maxLength {
 		return false
 	}
-	s := (t*d0.X + p0.X - p1.X) / d1.X
+	s := 0.0
+	if math.Abs(d1.X) <= dyn4go.Epsilon {
+		s = (t*d0.Y + p0.Y - p1.Y) / d1.Y
+	} else {
+		s = (t*d0.X + p0.X - p1.X) / d1.X
+	}
 	if s < 0 || s > 1 {
 		return false
 	}
@@ -0,0 +1,147 @@
+package test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/collision/manifold"
+	"github.com/LSFN/dyn4go/collision/narrowphase"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests that a box overlapping the end of a link at an internal vertex
+ * gets the face normal instead of a normal along the chain.
+ */
+func TestLinkPostProcessorInternalVertex(t *testing.T) {
+	links := geometry.CreateChain(
+		geometry.NewVector2FromXY(-1.0, 0.0),
+		geometry.NewVector2FromXY(0.0, 0.0),
+		geometry.NewVector2FromXY(1.0, 0.0),
+	)
+	box := geometry.CreateSquare(1.0)
+	t1 := geometry.NewTransform()
+	t2 := geometry.NewTransform()
+	t2.TranslateXY(0.47, 0.45)
+	detectors := []narrowphase.NarrowphaseDetector{narrowphase.NewGJK(), new(narrowphase.SAT)}
+	processor := new(narrowphase.LinkPostProcessor)
+	solver := new(manifold.ClippingManifoldSolver)
+	for _, detector := range detectors {
+		p := narrowphase.NewPenetration()
+		dyn4go.AssertTrue(t, detector.DetectPenetration(links[0], t1, box, t2, p))
+		dyn4go.AssertEqualWithinError(t, 1.0, math.Abs(p.GetNormal().X), 1.0e-6)
+		dyn4go.AssertTrue(t, processor.Process(links[0], t1, box, t2, p))
+		dyn4go.AssertEqualWithinError(t, 0.0, p.GetNormal().X, 1.0e-9)
+		dyn4go.AssertTrue(t, p.GetNormal().Y > 0)
+		dyn4go.AssertEqualWithinError(t, 1.0, p.GetNormal().Y, 1.0e-9)
+		dyn4go.AssertEqualWithinError(t, 0.05, p.GetDepth(), 1.0e-9)
+		m := manifold.NewManifold()
+		dyn4go.AssertTrue(t, solver.GetManifold(p, links[0], t1, box, t2, m))
+		dyn4go.AssertTrue(t, m.GetNormal().Y < 0)
+		dyn4go.AssertEqualWithinError(t, -1.0, m.GetNormal().Y, 1.0e-9)
+
+		p = narrowphase.NewPenetration()
+		dyn4go.AssertTrue(t, detector.DetectPenetration(box, t2, links[0], t1, p))
+		dyn4go.AssertTrue(t, processor.Process(box, t2, links[0], t1, p))
+		dyn4go.AssertEqualWithinError(t, 0.0, p.GetNormal().X, 1.0e-9)
+		dyn4go.AssertTrue(t, p.GetNormal().Y < 0)
+		dyn4go.AssertEqualWithinError(t, -1.0, p.GetNormal().Y, 1.0e-9)
+		dyn4go.AssertEqualWithinError(t, 0.05, p.GetDepth(), 1.0e-9)
+	}
+}
+
+/**
+ * Tests that penetrations at the free end of a chain are left alone.
+ */
+func TestLinkPostProcessorFreeEnd(t *testing.T) {
+	links := geometry.CreateChain(
+		geometry.NewVector2FromXY(-1.0, 0.0),
+		geometry.NewVector2FromXY(0.0, 0.0),
+	)
+	box := geometry.CreateSquare(1.0)
+	t1 := geometry.NewTransform()
+	t2 := geometry.NewTransform()
+	t2.TranslateXY(0.47, 0.45)
+	p := narrowphase.NewPenetration()
+	dyn4go.AssertTrue(t, narrowphase.NewGJK().DetectPenetration(links[0], t1, box, t2, p))
+	n := geometry.NewVector2FromVector2(p.GetNormal())
+	depth := p.GetDepth()
+	dyn4go.AssertTrue(t, new(narrowphase.LinkPostProcessor).Process(links[0], t1, box, t2, p))
+	dyn4go.AssertTrue(t, n.EqualsVector2(p.GetNormal()))
+	dyn4go.AssertEqual(t, depth, p.GetDepth())
+}
+
+/**
+ * Tests that a penetration is rejected when the corrected normal separates the shapes.
+ */
+func TestLinkPostProcessorSeparated(t *testing.T) {
+	links := geometry.CreateChain(
+		geometry.NewVector2FromXY(-1.0, 0.0),
+		geometry.NewVector2FromXY(0.0, 0.0),
+		geometry.NewVector2FromXY(0.0, 1.0),
+	)
+	circle := geometry.CreateCircle(0.5)
+	t1 := geometry.NewTransform()
+	t2 := geometry.NewTransform()
+	t2.TranslateXY(0.6, 0.7)
+	p := narrowphase.NewPenetration()
+	p.SetNormal(geometry.NewVector2FromXY(1.0, 0.0))
+	p.SetDepth(0.2)
+	dyn4go.AssertFalse(t, new(narrowphase.LinkPostProcessor).Process(links[0], t1, circle, t2, p))
+}
+
+/**
+ * Tests that a separation in the region of an adjacent link is rejected
+ * while face and free end separations are kept.
+ */
+func TestLinkPostProcessorSeparation(t *testing.T) {
+	links := geometry.CreateChain(
+		geometry.NewVector2FromXY(-1.0, 0.0),
+		geometry.NewVector2FromXY(0.0, 0.0),
+		geometry.NewVector2FromXY(1.0, 0.0),
+	)
+	box := geometry.CreateSquare(1.0)
+	t1 := geometry.NewTransform()
+	t2 := geometry.NewTransform()
+	t2.TranslateXY(0.6, 0.6)
+	processor := new(narrowphase.LinkPostProcessor)
+	gjk := narrowphase.NewGJK()
+
+	s := narrowphase.NewSeparation()
+	dyn4go.AssertTrue(t, gjk.Distance(links[0], t1, box, t2, s))
+	dyn4go.AssertTrue(t, s.GetNormal().X > 0)
+	dyn4go.AssertFalse(t, processor.ProcessSeparation(links[0], t1, box, t2, s))
+	dyn4go.AssertTrue(t, gjk.Distance(box, t2, links[0], t1, s))
+	dyn4go.AssertFalse(t, processor.ProcessSeparation(box, t2, links[0], t1, s))
+
+	dyn4go.AssertTrue(t, gjk.Distance(links[1], t1, box, t2, s))
+	dyn4go.AssertTrue(t, processor.ProcessSeparation(links[1], t1, box, t2, s))
+
+	t2.TranslateXY(1.0, 0.0)
+	dyn4go.AssertTrue(t, gjk.Distance(links[1], t1, box, t2, s))
+	dyn4go.AssertTrue(t, processor.ProcessSeparation(links[1], t1, box, t2, s))
+}
+
+/**
+ * Tests that SAT uses the true vertex region axis of a link, so a circle
+ * clear of the end vertex is not reported as colliding.
+ */
+func TestLinkSATVertexRegion(t *testing.T) {
+	links := geometry.CreateChain(
+		geometry.NewVector2FromXY(0.0, 0.0),
+		geometry.NewVector2FromXY(1.0, 0.0),
+		geometry.NewVector2FromXY(2.0, 0.0),
+	)
+	circle := geometry.CreateCircle(0.5)
+	t1 := geometry.NewTransform()
+	t2 := geometry.NewTransform()
+	t2.TranslateXY(1.4, -0.4)
+	sat := new(narrowphase.SAT)
+	dyn4go.AssertFalse(t, sat.Detect(links[0], t1, circle, t2))
+	dyn4go.AssertFalse(t, sat.Detect(circle, t2, links[0], t1))
+	dyn4go.AssertFalse(t, sat.DetectPenetration(links[0], t1, circle, t2, narrowphase.NewPenetration()))
+
+	t2.TranslateXY(0.0, 0.1)
+	dyn4go.AssertTrue(t, sat.Detect(links[0], t1, circle, t2))
+}
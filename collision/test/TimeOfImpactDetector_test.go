@@ -0,0 +1,127 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/collision/continuous"
+	"github.com/LSFN/dyn4go/collision/narrowphase"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func createTimeOfImpactTestShapes() []geometry.Convexer {
+	return []geometry.Convexer{
+		geometry.CreateCircle(0.5),
+		geometry.CreateSquare(1.0),
+		geometry.CreateRectangle(0.2, 1.5),
+		geometry.CreateEquilateralTriangle(1.0),
+		geometry.CreateUnitCirclePolygon(5, 0.5),
+		geometry.CreateCapsule(1.0, 0.4),
+		geometry.CreateEllipse(1.0, 0.5),
+		geometry.CreateHalfEllipse(1.0, 0.5),
+		geometry.CreateSlice(0.5, 1.0),
+		geometry.CreateSegment(geometry.NewVector2FromXY(0.0, -0.5), geometry.NewVector2FromXY(0.0, 0.5)),
+	}
+}
+
+/**
+ * Tests that bilateral advancement agrees with conservative advancement.
+ */
+func TestBilateralAdvancementConservativeAdvancement(t *testing.T) {
+	ca := continuous.NewConservativeAdvancement(narrowphase.NewGJK())
+	ba := continuous.NewBilateralAdvancement(narrowphase.NewGJK())
+	shapes := createTimeOfImpactTestShapes()
+	rotations := []float64{0.0, 0.5, -1.0}
+	for _, convex1 := range shapes {
+		for _, convex2 := range shapes {
+			for _, da1 := range rotations {
+				tx1 := geometry.NewTransform()
+				tx1.TranslateXY(-5.0, 0.1)
+				tx2 := geometry.NewTransform()
+				dp1 := geometry.NewVector2FromXY(10.0, 0.0)
+				dp2 := new(geometry.Vector2)
+
+				toi1 := new(continuous.TimeOfImpact)
+				toi2 := new(continuous.TimeOfImpact)
+				found1 := ca.GetTimeOfImpact(convex1, tx1, dp1, da1, convex2, tx2, dp2, 0, toi1)
+				found2 := ba.GetTimeOfImpact(convex1, tx1, dp1, da1, convex2, tx2, dp2, 0, toi2)
+				dyn4go.AssertTrue(t, found1)
+				dyn4go.AssertTrue(t, found2)
+				dyn4go.AssertEqualWithinError(t, toi1.GetTime(), toi2.GetTime(), 1.0e-4)
+				dyn4go.AssertTrue(t, toi2.GetSeparation().GetDistance() < 1.0e-4)
+			}
+		}
+	}
+}
+
+/**
+ * Tests that bilateral advancement finds no impact for shapes that miss.
+ */
+func TestBilateralAdvancementMiss(t *testing.T) {
+	ba := continuous.NewBilateralAdvancement(narrowphase.NewGJK())
+	tx1 := geometry.NewTransform()
+	tx1.TranslateXY(-5.0, 3.0)
+	tx2 := geometry.NewTransform()
+	toi := new(continuous.TimeOfImpact)
+	for _, convex1 := range createTimeOfImpactTestShapes() {
+		dyn4go.AssertFalse(t, ba.GetTimeOfImpact(convex1, tx1, geometry.NewVector2FromXY(10.0, 0.0), 0.5, geometry.CreateSquare(1.0), tx2, new(geometry.Vector2), 0, toi))
+	}
+
+	tx1.TranslateXY(0.0, -3.0)
+	dyn4go.AssertFalse(t, ba.GetTimeOfImpactBounded(geometry.CreateCircle(0.5), tx1, geometry.NewVector2FromXY(10.0, 0.0), 0, geometry.CreateSquare(1.0), tx2, new(geometry.Vector2), 0, 0, 0.25, toi))
+}
+
+/**
+ * Tests that bilateral advancement reports the last safe time when it runs
+ * out of iterations before reaching the target separation.
+ */
+func TestBilateralAdvancementMaxIterations(t *testing.T) {
+	ba := continuous.NewBilateralAdvancement(narrowphase.NewGJK())
+	tx1 := geometry.NewTransform()
+	tx1.TranslateXY(-5.0, 1.0)
+	tx2 := geometry.NewTransform()
+	dp1 := geometry.NewVector2FromXY(10.0, 0.0)
+	toi := new(continuous.TimeOfImpact)
+	dyn4go.AssertTrue(t, ba.GetTimeOfImpact(geometry.CreateCircle(0.5), tx1, dp1, 1.0, geometry.CreateCircle(0.5), tx2, new(geometry.Vector2), 0, toi))
+	dyn4go.AssertTrue(t, toi.GetSeparation().GetDistance() < 1.25*ba.GetDistanceEpsilon())
+
+	ba.SetMaxIterations(5)
+	toi = new(continuous.TimeOfImpact)
+	dyn4go.AssertTrue(t, ba.GetTimeOfImpact(geometry.CreateCircle(0.5), tx1, dp1, 1.0, geometry.CreateCircle(0.5), tx2, new(geometry.Vector2), 0, toi))
+	dyn4go.AssertTrue(t, toi.GetTime() > 0)
+	dyn4go.AssertTrue(t, toi.GetTime() <= 1)
+}
+
+/**
+ * Tests creating bilateral advancement with a nil distance detector.
+ */
+func TestBilateralAdvancementNilDetector(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	continuous.NewBilateralAdvancement(nil)
+}
+
+func benchmarkTimeOfImpactDetector(b *testing.B, detector continuous.TimeOfImpactDetector) {
+	shapes := createTimeOfImpactTestShapes()
+	tx1 := geometry.NewTransform()
+	tx1.TranslateXY(-5.0, 0.1)
+	tx2 := geometry.NewTransform()
+	dp1 := geometry.NewVector2FromXY(10.0, 0.0)
+	dp2 := new(geometry.Vector2)
+	toi := new(continuous.TimeOfImpact)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, convex1 := range shapes {
+			for _, convex2 := range shapes {
+				detector.GetTimeOfImpact(convex1, tx1, dp1, 2.0, convex2, tx2, dp2, -1.0, toi)
+			}
+		}
+	}
+}
+
+func BenchmarkConservativeAdvancement(b *testing.B) {
+	benchmarkTimeOfImpactDetector(b, continuous.NewConservativeAdvancement(narrowphase.NewGJK()))
+}
+
+func BenchmarkBilateralAdvancement(b *testing.B) {
+	benchmarkTimeOfImpactDetector(b, continuous.NewBilateralAdvancement(narrowphase.NewGJK()))
+}
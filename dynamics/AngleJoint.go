@@ -0,0 +1,219 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type AngleJoint struct {
+	Joint
+	referenceAngle         float64
+	ratio                  float64
+	limitEnabled           bool
+	lowerLimit, upperLimit float64
+	limitState             int
+	invK                   float64
+	impulse                float64
+}
+
+var _ Jointer = new(AngleJoint)
+
+func NewAngleJoint(body1, body2 *Body) *AngleJoint {
+	if body1 == body2 {
+		panic("Cannot create an angle joint between the same body")
+	}
+	a := new(AngleJoint)
+	InitJoint(&a.Joint, body1, body2, false)
+	a.referenceAngle = body1.transform.GetRotation() - body2.transform.GetRotation()
+	a.ratio = 1.0
+	a.limitEnabled = true
+	a.limitState = LIMIT_STATE_EQUAL
+	return a
+}
+
+func (a *AngleJoint) InitializeConstraints(step *Step, settings *Settings) {
+	angularTolerance := settings.GetAngularTolerance()
+	invI1 := a.body1.mass.GetInverseInertia()
+	invI2 := a.body2.mass.GetInverseInertia()
+
+	if a.limitEnabled {
+		angle := a.GetJointAngle()
+		if math.Abs(a.upperLimit-a.lowerLimit) < 2*angularTolerance {
+			a.limitState = LIMIT_STATE_EQUAL
+		} else if angle <= a.lowerLimit {
+			if a.limitState != LIMIT_STATE_AT_LOWER {
+				a.limitState = LIMIT_STATE_AT_LOWER
+				a.impulse = 0
+			}
+		} else if angle >= a.upperLimit {
+			if a.limitState != LIMIT_STATE_AT_UPPER {
+				a.limitState = LIMIT_STATE_AT_UPPER
+				a.impulse = 0
+			}
+		} else {
+			if a.limitState != LIMIT_STATE_INACTIVE {
+				a.limitState = LIMIT_STATE_INACTIVE
+				a.impulse = 0
+			}
+		}
+	} else {
+		if a.limitState != LIMIT_STATE_INACTIVE {
+			a.impulse = 0
+		}
+		a.limitState = LIMIT_STATE_INACTIVE
+	}
+
+	ratio := a.getImpulseRatio()
+	a.invK = invI1 + ratio*ratio*invI2
+	if a.invK > 0 {
+		a.invK = 1 / a.invK
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		a.impulse *= step.GetDeltaTimeRatio()
+	} else {
+		a.impulse = 0
+	}
+	a.applyImpulse(new(geometry.Vector2), a.impulse, ratio*a.impulse)
+}
+
+func (a *AngleJoint) getImpulseRatio() float64 {
+	if a.limitEnabled && a.limitState != LIMIT_STATE_INACTIVE {
+		return 1.0
+	}
+	return a.ratio
+}
+
+func (a *AngleJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	if a.limitEnabled && a.limitState == LIMIT_STATE_INACTIVE && a.ratio == 1.0 {
+		return
+	}
+
+	ratio := a.getImpulseRatio()
+	Cdot := a.body1.angularVelocity - ratio*a.body2.angularVelocity
+	impulse := a.invK * Cdot
+	oldImpulse := a.impulse
+	a.impulse += impulse
+	if a.limitState == LIMIT_STATE_AT_LOWER {
+		a.impulse = math.Min(a.impulse, 0)
+	} else if a.limitState == LIMIT_STATE_AT_UPPER {
+		a.impulse = math.Max(a.impulse, 0)
+	}
+	impulse = a.impulse - oldImpulse
+	a.applyImpulse(new(geometry.Vector2), impulse, ratio*impulse)
+}
+
+func (a *AngleJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	if !a.limitEnabled || a.limitState == LIMIT_STATE_INACTIVE {
+		return true
+	}
+	angularTolerance := settings.GetAngularTolerance()
+	maxAngularCorrection := settings.GetMaximumAngularCorrection()
+
+	angle := a.GetJointAngle()
+	C := 0.0
+	angularError := 0.0
+	if a.limitState == LIMIT_STATE_EQUAL {
+		C = geometry.IntervalClamp(angle-a.lowerLimit, -maxAngularCorrection, maxAngularCorrection)
+		angularError = math.Abs(C)
+	} else if a.limitState == LIMIT_STATE_AT_LOWER {
+		C = geometry.IntervalClamp(angle-a.lowerLimit+angularTolerance, -maxAngularCorrection, 0)
+		angularError = math.Max(0, a.lowerLimit-angle)
+	} else if a.limitState == LIMIT_STATE_AT_UPPER {
+		C = geometry.IntervalClamp(angle-a.upperLimit-angularTolerance, 0, maxAngularCorrection)
+		angularError = math.Max(0, angle-a.upperLimit)
+	}
+
+	impulse := a.invK * C
+	a.applyPositionImpulse(new(geometry.Vector2), impulse, impulse)
+
+	return angularError <= angularTolerance
+}
+
+func (a *AngleJoint) GetAnchor1() *geometry.Vector2 {
+	return a.body1.GetWorldCenter()
+}
+
+func (a *AngleJoint) GetAnchor2() *geometry.Vector2 {
+	return a.body2.GetWorldCenter()
+}
+
+func (a *AngleJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return new(geometry.Vector2)
+}
+
+func (a *AngleJoint) GetReactionTorque(invdt float64) float64 {
+	return a.impulse * invdt
+}
+
+func (a *AngleJoint) GetJointAngle() float64 {
+	return getRelativeRotation(a.body1, a.body2, a.referenceAngle)
+}
+
+func (a *AngleJoint) GetRatio() float64 {
+	return a.ratio
+}
+
+func (a *AngleJoint) SetRatio(ratio float64) {
+	if ratio <= 0 {
+		panic("Ratio must be greater than zero")
+	}
+	a.ratio = ratio
+	a.wakeBodies()
+}
+
+func (a *AngleJoint) IsLimitEnabled() bool {
+	return a.limitEnabled
+}
+
+func (a *AngleJoint) SetLimitEnabled(flag bool) {
+	a.limitEnabled = flag
+	a.wakeBodies()
+}
+
+func (a *AngleJoint) GetLowerLimit() float64 {
+	return a.lowerLimit
+}
+
+func (a *AngleJoint) SetLowerLimit(lowerLimit float64) {
+	if lowerLimit > a.upperLimit {
+		panic("Lower limit must not be greater than the upper limit")
+	}
+	a.lowerLimit = lowerLimit
+	a.wakeBodies()
+}
+
+func (a *AngleJoint) GetUpperLimit() float64 {
+	return a.upperLimit
+}
+
+func (a *AngleJoint) SetUpperLimit(upperLimit float64) {
+	if upperLimit < a.lowerLimit {
+		panic("Upper limit must not be less than the lower limit")
+	}
+	a.upperLimit = upperLimit
+	a.wakeBodies()
+}
+
+func (a *AngleJoint) SetLimits(lowerLimit, upperLimit float64) {
+	if lowerLimit > upperLimit {
+		panic("Lower limit must not be greater than the upper limit")
+	}
+	a.lowerLimit = lowerLimit
+	a.upperLimit = upperLimit
+	a.wakeBodies()
+}
+
+func (a *AngleJoint) GetLimitState() int {
+	return a.limitState
+}
+
+func (a *AngleJoint) GetReferenceAngle() float64 {
+	return a.referenceAngle
+}
+
+func (a *AngleJoint) SetReferenceAngle(referenceAngle float64) {
+	a.referenceAngle = referenceAngle
+	a.wakeBodies()
+}
@@ -0,0 +1,147 @@
+package dynamics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests setting invalid limits.
+ */
+func TestAngleJointInvalidLimits(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	j := NewAngleJoint(b1, b2)
+	j.SetLimits(1.0, -1.0)
+}
+
+/**
+ * Tests that equal limits lock the relative rotation.
+ */
+func TestAngleJointLocked(t *testing.T) {
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	b1.SetAngularDamping(0.0)
+	b2.SetAngularDamping(0.0)
+	w := NewWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j := NewAngleJoint(b1, b2)
+	w.AddJoint(j)
+	b1.SetAngularVelocity(2.0)
+
+	w.StepN(30)
+	dyn4go.AssertEqual(t, LIMIT_STATE_EQUAL, j.GetLimitState())
+	dyn4go.AssertEqualWithinError(t, 0.0, j.GetJointAngle(), 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, 1.0, b1.GetAngularVelocity(), 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, 1.0, b2.GetAngularVelocity(), 1.0e-3)
+}
+
+/**
+ * Tests that the relative rotation stays within the limits.
+ */
+func TestAngleJointLimits(t *testing.T) {
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	b1.SetAngularDamping(0.0)
+	b2.SetAngularDamping(0.0)
+	w := NewWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j := NewAngleJoint(b1, b2)
+	j.SetLimits(-math.Pi/4, math.Pi/4)
+	w.AddJoint(j)
+	b1.SetAngularVelocity(2.0)
+
+	w.StepN(10)
+	dyn4go.AssertEqual(t, LIMIT_STATE_INACTIVE, j.GetLimitState())
+	dyn4go.AssertEqualWithinError(t, 0.0, b2.GetAngularVelocity(), 1.0e-9)
+
+	w.StepN(60)
+	tolerance := 2 * w.GetSettings().GetAngularTolerance()
+	dyn4go.AssertEqual(t, LIMIT_STATE_AT_UPPER, j.GetLimitState())
+	dyn4go.AssertEqualWithinError(t, math.Pi/4, j.GetJointAngle(), tolerance)
+	dyn4go.AssertTrue(t, j.GetReactionTorque(w.GetStep().GetInverseDeltaTime()) >= 0)
+}
+
+/**
+ * Tests that the ratio couples the angular velocities.
+ */
+func TestAngleJointRatio(t *testing.T) {
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	b1.SetAngularDamping(0.0)
+	b2.SetAngularDamping(0.0)
+	w := NewWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j := NewAngleJoint(b1, b2)
+	j.SetLimitEnabled(false)
+	j.SetRatio(2.0)
+	w.AddJoint(j)
+	b1.SetAngularVelocity(2.0)
+
+	w.StepN(10)
+	dyn4go.AssertEqual(t, LIMIT_STATE_INACTIVE, j.GetLimitState())
+	dyn4go.AssertEqualWithinError(t, b1.GetAngularVelocity(), 2.0*b2.GetAngularVelocity(), 1.0e-6)
+}
+
+/**
+ * Tests that the ratio couples the angular velocities between the limits and
+ * that the limits still hold.
+ */
+func TestAngleJointRatioLimits(t *testing.T) {
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	b1.SetAngularDamping(0.0)
+	b2.SetAngularDamping(0.0)
+	w := NewWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j := NewAngleJoint(b1, b2)
+	j.SetLimits(-math.Pi/4, math.Pi/4)
+	j.SetRatio(2.0)
+	w.AddJoint(j)
+	b1.SetAngularVelocity(2.0)
+
+	w.StepN(10)
+	dyn4go.AssertEqual(t, LIMIT_STATE_INACTIVE, j.GetLimitState())
+	dyn4go.AssertTrue(t, b2.GetAngularVelocity() > 0)
+	dyn4go.AssertEqualWithinError(t, b1.GetAngularVelocity(), 2.0*b2.GetAngularVelocity(), 1.0e-6)
+
+	w.StepN(120)
+	tolerance := 2 * w.GetSettings().GetAngularTolerance()
+	dyn4go.AssertEqual(t, LIMIT_STATE_AT_UPPER, j.GetLimitState())
+	dyn4go.AssertEqualWithinError(t, math.Pi/4, j.GetJointAngle(), tolerance)
+	dyn4go.AssertEqualWithinError(t, b1.GetAngularVelocity(), b2.GetAngularVelocity(), 1.0e-6)
+}
+
+/**
+ * Tests setting a non-positive ratio.
+ */
+func TestAngleJointInvalidRatio(t *testing.T) {
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	j := NewAngleJoint(b1, b2)
+	func() {
+		defer dyn4go.AssertPanic(t)
+		j.SetRatio(0.0)
+	}()
+	func() {
+		defer dyn4go.AssertPanic(t)
+		j.SetRatio(-1.0)
+	}()
+}
@@ -0,0 +1,574 @@
+package dynamics
+
+import (
+	"math"
+
+	"code.google.com/p/uuid"
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/collision"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+const (
+	DEFAULT_LINEAR_DAMPING  = 0.0
+	DEFAULT_ANGULAR_DAMPING = 0.01
+)
+
+type Body struct {
+	id              string
+	fixtures        []*BodyFixture
+	joints          []Jointer
+	transform       *geometry.Transform
+	transform0      *geometry.Transform
+	mass            *geometry.Mass
+	radius          float64
+	velocity        *geometry.Vector2
+	angularVelocity float64
+	force           *geometry.Vector2
+	torque          float64
+	linearDamping   float64
+	angularDamping  float64
+	gravityScale    float64
+	autoSleep       bool
+	asleep          bool
+	sleepTime       float64
+	bullet          bool
+	userData        interface{}
+}
+
+var _ collision.Collider = new(Body)
+
+func NewBody() *Body {
+	return NewBodyInt(1)
+}
+
+func NewBodyInt(fixtureCount int) *Body {
+	b := new(Body)
+	b.id = uuid.New()
+	b.fixtures = make([]*BodyFixture, 0, fixtureCount)
+	b.joints = make([]Jointer, 0)
+	b.transform = geometry.NewTransform()
+	b.transform0 = geometry.NewTransform()
+	b.mass = geometry.NewMass()
+	b.velocity = new(geometry.Vector2)
+	b.force = new(geometry.Vector2)
+	b.linearDamping = DEFAULT_LINEAR_DAMPING
+	b.angularDamping = DEFAULT_ANGULAR_DAMPING
+	b.gravityScale = 1
+	b.autoSleep = true
+	return b
+}
+
+func (b *Body) GetID() string {
+	return b.id
+}
+
+func (b *Body) AddFixture(fixture *BodyFixture) *Body {
+	if fixture == nil {
+		panic("Cannot add nil fixture to body")
+	}
+	b.fixtures = append(b.fixtures, fixture)
+	return b
+}
+
+func (b *Body) AddFixtureConvex(convex geometry.Convexer) *BodyFixture {
+	fixture := NewBodyFixture(convex)
+	b.fixtures = append(b.fixtures, fixture)
+	return fixture
+}
+
+func (b *Body) RemoveFixture(fixture *BodyFixture) bool {
+	for i, f := range b.fixtures {
+		if f == fixture {
+			b.fixtures = append(b.fixtures[:i], b.fixtures[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Body) RemoveFixtureIndex(index int) *BodyFixture {
+	if index < 0 || index >= len(b.fixtures) {
+		return nil
+	}
+	fixture := b.fixtures[index]
+	b.fixtures = append(b.fixtures[:index], b.fixtures[index+1:]...)
+	return fixture
+}
+
+func (b *Body) RemoveAllFixtures() []*BodyFixture {
+	fixtures := b.fixtures
+	b.fixtures = make([]*BodyFixture, 0, 1)
+	return fixtures
+}
+
+func (b *Body) ContainsFixture(fixture *BodyFixture) bool {
+	for _, f := range b.fixtures {
+		if f == fixture {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Body) GetFixture(index int) collision.Fixturer {
+	if index < 0 || index >= len(b.fixtures) {
+		panic("No fixture for that index")
+	}
+	return b.fixtures[index]
+}
+
+func (b *Body) GetBodyFixture(index int) *BodyFixture {
+	if index < 0 || index >= len(b.fixtures) {
+		panic("No fixture for that index")
+	}
+	return b.fixtures[index]
+}
+
+func (b *Body) GetFixtureCount() int {
+	return len(b.fixtures)
+}
+
+func (b *Body) GetFixtures() []collision.Fixturer {
+	fixtures := make([]collision.Fixturer, len(b.fixtures))
+	for i, f := range b.fixtures {
+		fixtures[i] = f
+	}
+	return fixtures
+}
+
+func (b *Body) GetBodyFixtures() []*BodyFixture {
+	return b.fixtures
+}
+
+func (b *Body) GetJoints() []Jointer {
+	return b.joints
+}
+
+func (b *Body) GetJointedBodies() []*Body {
+	bodies := make([]*Body, 0, len(b.joints))
+	for _, joint := range b.joints {
+		bodies = append(bodies, b.getOtherBody(joint))
+	}
+	return bodies
+}
+
+func (b *Body) IsConnected(body *Body) bool {
+	for _, joint := range b.joints {
+		if b.getOtherBody(joint) == body {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Body) IsConnectedCollisionAllowed(body *Body, collisionAllowed bool) bool {
+	for _, joint := range b.joints {
+		if b.getOtherBody(joint) == body && joint.IsCollisionAllowed() == collisionAllowed {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *Body) getOtherBody(joint Jointer) *Body {
+	if joint.GetBody1() == b {
+		return joint.GetBody2()
+	}
+	return joint.GetBody1()
+}
+
+func (b *Body) removeJoint(joint Jointer) {
+	for i, j := range b.joints {
+		if j == joint {
+			b.joints = append(b.joints[:i], b.joints[i+1:]...)
+			return
+		}
+	}
+}
+
+func (b *Body) UpdateMass() *Body {
+	return b.UpdateMassWithType(geometry.NORMAL)
+}
+
+func (b *Body) UpdateMassWithType(massType int) *Body {
+	if len(b.fixtures) == 0 {
+		b.mass = geometry.NewMass()
+	} else {
+		masses := make([]*geometry.Mass, len(b.fixtures))
+		for i, f := range b.fixtures {
+			masses[i] = f.CreateMass()
+		}
+		b.mass = geometry.CreateMass(masses)
+		b.mass.SetType(massType)
+	}
+	b.updateRadius()
+	return b
+}
+
+func (b *Body) SetMass(mass *geometry.Mass) *Body {
+	if mass == nil {
+		panic("Cannot set mass of body to nil")
+	}
+	b.mass = mass
+	b.updateRadius()
+	return b
+}
+
+func (b *Body) SetMassType(massType int) *Body {
+	b.mass.SetType(massType)
+	return b
+}
+
+func (b *Body) GetMass() *geometry.Mass {
+	return b.mass
+}
+
+func (b *Body) updateRadius() {
+	center := b.mass.GetCenter()
+	b.radius = 0
+	for _, f := range b.fixtures {
+		b.radius = math.Max(b.radius, f.GetShape().GetRadiusVector2(center))
+	}
+}
+
+func (b *Body) GetRotationDiscRadius() float64 {
+	return b.radius
+}
+
+func (b *Body) IsStatic() bool {
+	return b.mass.GetType() == geometry.INFINITE && b.velocity.IsZero() && math.Abs(b.angularVelocity) <= dyn4go.Epsilon
+}
+
+func (b *Body) IsKinematic() bool {
+	return b.mass.GetType() == geometry.INFINITE && (!b.velocity.IsZero() || math.Abs(b.angularVelocity) > dyn4go.Epsilon)
+}
+
+func (b *Body) IsDynamic() bool {
+	return b.mass.GetType() != geometry.INFINITE
+}
+
+func (b *Body) ApplyForce(force *geometry.Vector2) *Body {
+	if force == nil {
+		panic("Cannot apply nil force")
+	}
+	b.force.AddVector2(force)
+	b.SetAsleep(false)
+	return b
+}
+
+func (b *Body) ApplyForceAtWorldPoint(force, point *geometry.Vector2) *Body {
+	if force == nil || point == nil {
+		panic("Cannot apply force with nil force or point")
+	}
+	b.force.AddVector2(force)
+	r := b.GetWorldCenter().HereToVector2(point)
+	b.torque += r.CrossVector2(force)
+	b.SetAsleep(false)
+	return b
+}
+
+func (b *Body) ApplyForceAtLocalPoint(force, point *geometry.Vector2) *Body {
+	if force == nil || point == nil {
+		panic("Cannot apply force with nil force or point")
+	}
+	return b.ApplyForceAtWorldPoint(force, b.GetWorldPoint(point))
+}
+
+func (b *Body) ApplyTorque(torque float64) *Body {
+	b.torque += torque
+	b.SetAsleep(false)
+	return b
+}
+
+func (b *Body) ApplyImpulse(impulse *geometry.Vector2) *Body {
+	if impulse == nil {
+		panic("Cannot apply nil impulse")
+	}
+	b.velocity.AddVector2(impulse.Product(b.mass.GetInverseMass()))
+	b.SetAsleep(false)
+	return b
+}
+
+func (b *Body) ApplyImpulseAtWorldPoint(impulse, point *geometry.Vector2) *Body {
+	if impulse == nil || point == nil {
+		panic("Cannot apply impulse with nil impulse or point")
+	}
+	b.velocity.AddVector2(impulse.Product(b.mass.GetInverseMass()))
+	r := b.GetWorldCenter().HereToVector2(point)
+	b.angularVelocity += b.mass.GetInverseInertia() * r.CrossVector2(impulse)
+	b.SetAsleep(false)
+	return b
+}
+
+func (b *Body) ApplyImpulseAtLocalPoint(impulse, point *geometry.Vector2) *Body {
+	if impulse == nil || point == nil {
+		panic("Cannot apply impulse with nil impulse or point")
+	}
+	return b.ApplyImpulseAtWorldPoint(impulse, b.GetWorldPoint(point))
+}
+
+func (b *Body) ApplyAngularImpulse(impulse float64) *Body {
+	b.angularVelocity += b.mass.GetInverseInertia() * impulse
+	b.SetAsleep(false)
+	return b
+}
+
+func (b *Body) ClearForce() {
+	b.force.Zero()
+}
+
+func (b *Body) ClearTorque() {
+	b.torque = 0
+}
+
+func (b *Body) GetForce() *geometry.Vector2 {
+	return b.force
+}
+
+func (b *Body) GetTorque() float64 {
+	return b.torque
+}
+
+func (b *Body) GetVelocity() *geometry.Vector2 {
+	return b.velocity
+}
+
+func (b *Body) SetVelocity(velocity *geometry.Vector2) {
+	if velocity == nil {
+		panic("Cannot set velocity to nil")
+	}
+	b.velocity.SetToVector2(velocity)
+}
+
+func (b *Body) GetAngularVelocity() float64 {
+	return b.angularVelocity
+}
+
+func (b *Body) SetAngularVelocity(angularVelocity float64) {
+	b.angularVelocity = angularVelocity
+}
+
+func (b *Body) GetVelocityAtWorldPoint(point *geometry.Vector2) *geometry.Vector2 {
+	r := b.GetWorldCenter().HereToVector2(point)
+	return r.CrossZ(b.angularVelocity).AddVector2(b.velocity)
+}
+
+func (b *Body) GetLinearDamping() float64 {
+	return b.linearDamping
+}
+
+func (b *Body) SetLinearDamping(linearDamping float64) {
+	if linearDamping < 0 {
+		panic("Linear damping must not be negative")
+	}
+	b.linearDamping = linearDamping
+}
+
+func (b *Body) GetAngularDamping() float64 {
+	return b.angularDamping
+}
+
+func (b *Body) SetAngularDamping(angularDamping float64) {
+	if angularDamping < 0 {
+		panic("Angular damping must not be negative")
+	}
+	b.angularDamping = angularDamping
+}
+
+func (b *Body) GetGravityScale() float64 {
+	return b.gravityScale
+}
+
+func (b *Body) SetGravityScale(gravityScale float64) {
+	b.gravityScale = gravityScale
+}
+
+func (b *Body) IsAutoSleepingEnabled() bool {
+	return b.autoSleep
+}
+
+func (b *Body) SetAutoSleepingEnabled(flag bool) {
+	b.autoSleep = flag
+	if !flag {
+		b.SetAsleep(false)
+	}
+}
+
+func (b *Body) IsAsleep() bool {
+	return b.asleep
+}
+
+func (b *Body) SetAsleep(flag bool) {
+	if flag {
+		b.velocity.Zero()
+		b.angularVelocity = 0
+		b.ClearForce()
+		b.ClearTorque()
+	}
+	b.asleep = flag
+	b.sleepTime = 0
+}
+
+func (b *Body) isAwake() bool {
+	return !b.asleep && !b.IsStatic()
+}
+
+func (b *Body) integratePosition(step *Step, settings *Settings) {
+	maxTranslation := settings.GetMaximumTranslation()
+	maxRotation := settings.GetMaximumRotation()
+	translationX := b.velocity.X * step.dt
+	translationY := b.velocity.Y * step.dt
+	translation2 := translationX*translationX + translationY*translationY
+	if translation2 > maxTranslation*maxTranslation {
+		ratio := maxTranslation / math.Sqrt(translation2)
+		b.velocity.Multiply(ratio)
+		translationX *= ratio
+		translationY *= ratio
+	}
+	rotation := b.angularVelocity * step.dt
+	if math.Abs(rotation) > maxRotation {
+		ratio := maxRotation / math.Abs(rotation)
+		b.angularVelocity *= ratio
+		rotation *= ratio
+	}
+	b.TranslateXY(translationX, translationY)
+	b.RotateAboutCenter(rotation)
+}
+
+func (b *Body) addVelocity(velocity *geometry.Vector2, angularVelocity float64) {
+	if !b.IsDynamic() {
+		return
+	}
+	b.velocity.AddVector2(velocity)
+	b.angularVelocity += angularVelocity
+}
+
+func (b *Body) subtractVelocity(velocity *geometry.Vector2, angularVelocity float64) {
+	if !b.IsDynamic() {
+		return
+	}
+	b.velocity.SubtractVector2(velocity)
+	b.angularVelocity -= angularVelocity
+}
+
+func (b *Body) addAngularVelocity(angularVelocity float64) {
+	if !b.IsDynamic() {
+		return
+	}
+	b.angularVelocity += angularVelocity
+}
+
+func (b *Body) correctPosition(translation *geometry.Vector2, rotation float64) {
+	if !b.IsDynamic() {
+		return
+	}
+	b.TranslateVector2(translation)
+	b.RotateAboutCenter(rotation)
+}
+
+func (b *Body) GetTransform() *geometry.Transform {
+	return b.transform
+}
+
+func (b *Body) SetTransform(transform *geometry.Transform) {
+	if transform == nil {
+		panic("Cannot set transform to nil")
+	}
+	b.transform.Set(transform)
+}
+
+func (b *Body) GetInitialTransform() *geometry.Transform {
+	return b.transform0
+}
+
+func (b *Body) IsBullet() bool {
+	return b.bullet
+}
+
+func (b *Body) SetBullet(flag bool) {
+	b.bullet = flag
+}
+
+func (b *Body) GetLocalCenter() *geometry.Vector2 {
+	return b.mass.GetCenter()
+}
+
+func (b *Body) GetWorldCenter() *geometry.Vector2 {
+	return b.transform.GetTransformedVector2(b.mass.GetCenter())
+}
+
+func (b *Body) GetLocalPoint(worldPoint *geometry.Vector2) *geometry.Vector2 {
+	return b.transform.GetInverseTransformedVector2(worldPoint)
+}
+
+func (b *Body) GetWorldPoint(localPoint *geometry.Vector2) *geometry.Vector2 {
+	return b.transform.GetTransformedVector2(localPoint)
+}
+
+func (b *Body) GetLocalVector(worldVector *geometry.Vector2) *geometry.Vector2 {
+	return b.transform.GetInverseTransformedR(worldVector)
+}
+
+func (b *Body) GetWorldVector(localVector *geometry.Vector2) *geometry.Vector2 {
+	return b.transform.GetTransformedR(localVector)
+}
+
+func (b *Body) RotateAboutOrigin(theta float64) {
+	b.transform.RotateAboutOrigin(theta)
+}
+
+func (b *Body) RotateAboutVector2(theta float64, point *geometry.Vector2) {
+	b.transform.RotateAboutVector2(theta, point)
+}
+
+func (b *Body) RotateAboutXY(theta, x, y float64) {
+	b.transform.RotateAboutXY(theta, x, y)
+}
+
+func (b *Body) RotateAboutCenter(theta float64) {
+	b.transform.RotateAboutVector2(theta, b.GetWorldCenter())
+}
+
+func (b *Body) TranslateXY(x, y float64) {
+	b.transform.TranslateXY(x, y)
+}
+
+func (b *Body) TranslateVector2(vector *geometry.Vector2) {
+	b.transform.TranslateVector2(vector)
+}
+
+func (b *Body) TranslateToOrigin() {
+	wc := b.GetWorldCenter()
+	b.transform.TranslateXY(-wc.X, -wc.Y)
+}
+
+func (b *Body) CreateAABB() *geometry.AABB {
+	if len(b.fixtures) > 0 {
+		aabb := b.fixtures[0].GetShape().CreateAABBTransform(b.transform)
+		for i := 1; i < len(b.fixtures); i++ {
+			aabb.Union(b.fixtures[i].GetShape().CreateAABBTransform(b.transform))
+		}
+		return aabb
+	}
+	return geometry.NewAABBFromFloats(0, 0, 0, 0)
+}
+
+func (b *Body) CreateSweptAABB() *geometry.AABB {
+	return b.CreateSweptAABBTransform(b.transform0, b.transform)
+}
+
+func (b *Body) CreateSweptAABBTransform(initialTransform, finalTransform *geometry.Transform) *geometry.AABB {
+	center := b.mass.GetCenter()
+	aabb := geometry.NewAABBFromCenterRadius(initialTransform.GetTransformedVector2(center), b.radius)
+	aabb.Union(geometry.NewAABBFromCenterRadius(finalTransform.GetTransformedVector2(center), b.radius))
+	return aabb
+}
+
+func (b *Body) GetUserData() interface{} {
+	return b.userData
+}
+
+func (b *Body) SetUserData(data interface{}) {
+	b.userData = data
+}
@@ -1,8 +1,12 @@
 package dynamics
 
 import (
+	"errors"
+	"math"
+
 	"github.com/LSFN/dyn4go/collision"
 	"github.com/LSFN/dyn4go/geometry"
+	"github.com/LSFN/dyn4go/geometry/decompose"
 )
 
 type BodyFixture struct {
@@ -10,6 +14,12 @@ type BodyFixture struct {
 	density, friction, restitution float64
 }
 
+const (
+	POLYGON_MASS_TOLERANCE = 1.0e-12
+)
+
+var ErrMassMismatch = errors.New("dynamics: fixture mass does not match the polygon mass")
+
 var _ collision.Fixturer = new(BodyFixture)
 
 func NewBodyFixture(shape geometry.Convexer) *BodyFixture {
@@ -21,6 +31,94 @@ func NewBodyFixture(shape geometry.Convexer) *BodyFixture {
 	return b
 }
 
+func CreatePolygonFixtures(points []*geometry.Vector2, holes [][]*geometry.Vector2, density, friction, restitution float64, filter collision.Filterer) ([]*BodyFixture, error) {
+	var convexes []geometry.Convexer
+	var err error
+	if len(holes) == 0 {
+		convexes, err = new(decompose.Bayazit).Decompose(points)
+	} else {
+		convexes, err = new(decompose.EarClipping).DecomposeWithHoles(points, holes...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if filter == nil {
+		filter = collision.NewDefaultFilter()
+	}
+	fixtures := make([]*BodyFixture, len(convexes))
+	for i, convex := range convexes {
+		fixture := NewBodyFixture(convex)
+		fixture.SetDensity(density)
+		fixture.SetFriction(friction)
+		fixture.SetRestitution(restitution)
+		fixture.SetFilter(filter)
+		fixtures[i] = fixture
+	}
+	if err := checkPolygonFixturesMass(fixtures, points, holes, density); err != nil {
+		return nil, err
+	}
+	return fixtures, nil
+}
+
+func checkPolygonFixturesMass(fixtures []*BodyFixture, points []*geometry.Vector2, holes [][]*geometry.Vector2, density float64) error {
+	origin := points[0]
+	count := len(points)
+	extent := getPolygonExtent(points)
+	area, moment, secondMoment := getPolygonMoments(points, origin)
+	for _, hole := range holes {
+		a, m, j := getPolygonMoments(hole, origin)
+		area -= a
+		moment.SubtractVector2(m)
+		secondMoment -= j
+		count += len(hole)
+		extent = math.Max(extent, getPolygonExtent(hole))
+	}
+	mass := density * area
+	local := moment.Multiply(1 / area)
+	inertia := density*secondMoment - mass*local.GetMagnitudeSquared()
+	center := local.SumVector2(origin)
+
+	masses := make([]*geometry.Mass, len(fixtures))
+	for i, fixture := range fixtures {
+		masses[i] = fixture.CreateMass()
+	}
+	combined := geometry.CreateMass(masses)
+	radius := math.Sqrt(inertia / mass)
+	tolerance := POLYGON_MASS_TOLERANCE * float64(count) * math.Max(1, extent/radius)
+	if math.Abs(combined.GetMass()-mass) > tolerance*mass ||
+		combined.GetCenter().DistanceFromVector2(center) > tolerance*radius ||
+		math.Abs(combined.GetInertia()-inertia) > tolerance*inertia {
+		return ErrMassMismatch
+	}
+	return nil
+}
+
+func getPolygonMoments(points []*geometry.Vector2, origin *geometry.Vector2) (float64, *geometry.Vector2, float64) {
+	area := 0.0
+	moment := new(geometry.Vector2)
+	secondMoment := 0.0
+	for i := range points {
+		p1 := points[i].DifferenceVector2(origin)
+		p2 := points[(i+1)%len(points)].DifferenceVector2(origin)
+		cross := p1.CrossVector2(p2)
+		area += 0.5 * cross
+		moment.AddVector2(p1.SumVector2(p2).Multiply(cross / 6))
+		secondMoment += cross * (p1.DotVector2(p1) + p1.DotVector2(p2) + p2.DotVector2(p2)) / 12
+	}
+	if area < 0 {
+		return -area, moment.Negate(), -secondMoment
+	}
+	return area, moment, secondMoment
+}
+
+func getPolygonExtent(points []*geometry.Vector2) float64 {
+	extent := 0.0
+	for _, p := range points {
+		extent = math.Max(extent, math.Max(math.Abs(p.X), math.Abs(p.Y)))
+	}
+	return extent
+}
+
 func (b *BodyFixture) SetDensity(density float64) {
 	if density <= 0 {
 		panic("Density must be strictly positive")
@@ -0,0 +1,214 @@
+package dynamics
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/collision"
+	"github.com/LSFN/dyn4go/geometry"
+	"github.com/LSFN/dyn4go/geometry/decompose"
+)
+
+func createBodyFixtureTestPolygon(coordinates ...float64) []*geometry.Vector2 {
+	points := make([]*geometry.Vector2, len(coordinates)/2)
+	for i := range points {
+		points[i] = geometry.NewVector2FromXY(coordinates[2*i], coordinates[2*i+1])
+	}
+	return points
+}
+
+func getBodyFixtureTestMass(points []*geometry.Vector2, density float64) (float64, *geometry.Vector2, float64) {
+	area := 0.0
+	center := new(geometry.Vector2)
+	inertia := 0.0
+	for i, p1 := range points {
+		p2 := points[(i+1)%len(points)]
+		cross := p1.CrossVector2(p2)
+		area += 0.5 * cross
+		center.AddVector2(p1.SumVector2(p2).Multiply(cross / 6.0))
+		inertia += cross * (p1.DotVector2(p1) + p1.DotVector2(p2) + p2.DotVector2(p2)) / 12.0
+	}
+	center.Multiply(1.0 / area)
+	mass := density * area
+	return math.Abs(mass), center, math.Abs(density*inertia) - math.Abs(mass)*center.GetMagnitudeSquared()
+}
+
+func isBodyFixtureTestContained(fixtures []*BodyFixture, p *geometry.Vector2) bool {
+	for _, fixture := range fixtures {
+		if fixture.GetShape().ContainsVector2(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func assertBodyFixtureMass(t *testing.T, fixtures []*BodyFixture, points []*geometry.Vector2, holes [][]*geometry.Vector2, density float64) {
+	mass, center, inertia := getBodyFixtureTestMass(points, density)
+	inertia += mass * center.GetMagnitudeSquared()
+	center.Multiply(mass)
+	for _, hole := range holes {
+		m, c, i := getBodyFixtureTestMass(hole, density)
+		mass -= m
+		center.SubtractVector2(c.Product(m))
+		inertia -= i + m*c.GetMagnitudeSquared()
+	}
+	center.Multiply(1.0 / mass)
+	inertia -= mass * center.GetMagnitudeSquared()
+
+	masses := make([]*geometry.Mass, len(fixtures))
+	for i, fixture := range fixtures {
+		masses[i] = fixture.CreateMass()
+	}
+	combined := geometry.CreateMass(masses)
+	dyn4go.AssertTrue(t, math.Abs(combined.GetMass()-mass) < 1.0e-9*mass)
+	dyn4go.AssertTrue(t, combined.GetCenter().DistanceFromVector2(center) < 1.0e-9)
+	dyn4go.AssertTrue(t, math.Abs(combined.GetInertia()-inertia) < 1.0e-9*inertia)
+}
+
+/**
+ * Tests creating fixtures from a concave polygon.
+ */
+func TestBodyFixtureCreatePolygonFixtures(t *testing.T) {
+	points := createBodyFixtureTestPolygon(0.0, 0.0, 4.0, 0.0, 4.0, 3.0, 3.0, 3.0, 3.0, 1.0, 1.0, 1.0, 1.0, 3.0, 0.0, 3.0)
+	filter := new(worldTestFilter)
+	fixtures, err := CreatePolygonFixtures(points, nil, 2.5, 0.7, 0.3, filter)
+	dyn4go.AssertTrue(t, err == nil)
+	dyn4go.AssertTrue(t, len(fixtures) > 1)
+	for _, fixture := range fixtures {
+		dyn4go.AssertEqual(t, 2.5, fixture.GetDensity())
+		dyn4go.AssertEqual(t, 0.7, fixture.GetFriction())
+		dyn4go.AssertEqual(t, 0.3, fixture.GetRestitution())
+		dyn4go.AssertTrue(t, fixture.GetFilter() == filter)
+	}
+	assertBodyFixtureMass(t, fixtures, points, nil, 2.5)
+}
+
+/**
+ * Tests creating fixtures from a concave polygon with holes.
+ */
+func TestBodyFixtureCreatePolygonFixturesHoles(t *testing.T) {
+	points := createBodyFixtureTestPolygon(0.0, 0.0, 10.0, 0.0, 10.0, 6.0, 6.0, 6.0, 5.0, 9.0, 4.0, 6.0, 0.0, 6.0)
+	holes := [][]*geometry.Vector2{
+		createBodyFixtureTestPolygon(2.0, 2.0, 3.0, 2.0, 3.0, 4.0, 2.0, 4.0),
+		createBodyFixtureTestPolygon(7.0, 2.0, 8.5, 3.0, 7.0, 4.0),
+		createBodyFixtureTestPolygon(4.5, 6.5, 5.5, 6.5, 5.0, 7.5),
+	}
+	fixtures, err := CreatePolygonFixtures(points, holes, 1.5, 0.2, 0.0, collision.NewDefaultFilter())
+	dyn4go.AssertTrue(t, err == nil)
+	assertBodyFixtureMass(t, fixtures, points, holes, 1.5)
+
+	dyn4go.AssertFalse(t, isBodyFixtureTestContained(fixtures, geometry.NewVector2FromXY(2.5, 3.0)))
+	dyn4go.AssertFalse(t, isBodyFixtureTestContained(fixtures, geometry.NewVector2FromXY(7.5, 3.0)))
+	dyn4go.AssertFalse(t, isBodyFixtureTestContained(fixtures, geometry.NewVector2FromXY(5.0, 6.9)))
+	dyn4go.AssertTrue(t, isBodyFixtureTestContained(fixtures, geometry.NewVector2FromXY(5.0, 3.0)))
+	dyn4go.AssertTrue(t, isBodyFixtureTestContained(fixtures, geometry.NewVector2FromXY(5.0, 8.0)))
+}
+
+/**
+ * Tests creating fixtures from rotated rectilinear polygons, with and without
+ * holes, returns convex pieces instead of failing on rounding error.
+ */
+func TestBodyFixtureCreatePolygonFixturesRotated(t *testing.T) {
+	for degrees := 0; degrees < 360; degrees++ {
+		comb := createBodyFixtureTestPolygon(0.0, 0.0, 6.0, 0.0, 6.0, 3.0, 5.0, 3.0, 5.0, 1.0, 4.0, 1.0, 4.0, 4.0, 3.0, 4.0, 3.0, 1.0, 2.0, 1.0, 2.0, 2.0, 1.0, 2.0, 1.0, 1.0, 0.0, 1.0)
+		square := createBodyFixtureTestPolygon(0.0, 0.0, 6.0, 0.0, 6.0, 6.0, 0.0, 6.0)
+		holes := [][]*geometry.Vector2{createBodyFixtureTestPolygon(1.0, 1.0, 2.0, 1.0, 2.0, 2.0, 1.0, 2.0)}
+		for _, points := range [][]*geometry.Vector2{comb, square, holes[0]} {
+			for _, p := range points {
+				p.RotateAboutOrigin(dyn4go.DegToRad(float64(degrees)))
+			}
+		}
+		fixtures, err := CreatePolygonFixtures(comb, nil, 1.0, 0.2, 0.0, collision.NewDefaultFilter())
+		dyn4go.AssertTrue(t, err == nil)
+		assertBodyFixtureMass(t, fixtures, comb, nil, 1.0)
+		fixtures, err = CreatePolygonFixtures(square, holes, 1.0, 0.2, 0.0, collision.NewDefaultFilter())
+		dyn4go.AssertTrue(t, err == nil)
+		assertBodyFixtureMass(t, fixtures, square, holes, 1.0)
+	}
+}
+
+/**
+ * Tests creating fixtures from concave polygons, with and without holes, far
+ * from the origin and without a filter.
+ */
+func TestBodyFixtureCreatePolygonFixturesOffset(t *testing.T) {
+	u := createBodyFixtureTestPolygon(0.0, 0.0, 4.0, 0.0, 4.0, 3.0, 3.0, 3.0, 3.0, 1.0, 1.0, 1.0, 1.0, 3.0, 0.0, 3.0)
+	house := createBodyFixtureTestPolygon(0.0, 0.0, 10.0, 0.0, 10.0, 6.0, 6.0, 6.0, 5.0, 9.0, 4.0, 6.0, 0.0, 6.0)
+	holes := [][]*geometry.Vector2{
+		createBodyFixtureTestPolygon(2.0, 2.0, 3.0, 2.0, 3.0, 4.0, 2.0, 4.0),
+		createBodyFixtureTestPolygon(7.0, 2.0, 8.5, 3.0, 7.0, 4.0),
+		createBodyFixtureTestPolygon(4.5, 6.5, 5.5, 6.5, 5.0, 7.5),
+	}
+	for _, offset := range []float64{1.0e3, 1.0e4, 1.0e6} {
+		for _, polygon := range []struct {
+			points []*geometry.Vector2
+			holes  [][]*geometry.Vector2
+		}{{u, nil}, {house, holes}} {
+			fixtures, err := CreatePolygonFixtures(polygon.points, polygon.holes, 1.0, 0.2, 0.0, nil)
+			dyn4go.AssertTrue(t, err == nil)
+			expected := make([]*geometry.Mass, len(fixtures))
+			for i, fixture := range fixtures {
+				expected[i] = fixture.CreateMass()
+			}
+			mass := geometry.CreateMass(expected)
+
+			translate := func(points []*geometry.Vector2) []*geometry.Vector2 {
+				translated := make([]*geometry.Vector2, len(points))
+				for i, p := range points {
+					translated[i] = geometry.NewVector2FromXY(p.X+offset, p.Y+offset)
+				}
+				return translated
+			}
+			points := translate(polygon.points)
+			var translatedHoles [][]*geometry.Vector2
+			for _, hole := range polygon.holes {
+				translatedHoles = append(translatedHoles, translate(hole))
+			}
+			fixtures, err = CreatePolygonFixtures(points, translatedHoles, 1.0, 0.2, 0.0, nil)
+			dyn4go.AssertTrue(t, err == nil)
+			masses := make([]*geometry.Mass, len(fixtures))
+			for i, fixture := range fixtures {
+				masses[i] = fixture.CreateMass()
+				_, ok := fixture.GetFilter().(*collision.DefaultFilter)
+				dyn4go.AssertTrue(t, ok)
+			}
+			combined := geometry.CreateMass(masses)
+			dyn4go.AssertEqualWithinError(t, mass.GetMass(), combined.GetMass(), 1.0e-6*mass.GetMass())
+			dyn4go.AssertEqualWithinError(t, mass.GetCenter().X+offset, combined.GetCenter().X, 1.0e-6)
+			dyn4go.AssertEqualWithinError(t, mass.GetCenter().Y+offset, combined.GetCenter().Y, 1.0e-6)
+			dyn4go.AssertEqualWithinError(t, mass.GetInertia(), combined.GetInertia(), 1.0e-6*mass.GetInertia())
+		}
+	}
+}
+
+/**
+ * Tests that fixtures whose combined mass differs from the polygon are rejected.
+ */
+func TestBodyFixtureCheckPolygonFixturesMass(t *testing.T) {
+	points := createBodyFixtureTestPolygon(0.0, 0.0, 4.0, 0.0, 4.0, 3.0, 3.0, 3.0, 3.0, 1.0, 1.0, 1.0, 1.0, 3.0, 0.0, 3.0)
+	fixtures, err := CreatePolygonFixtures(points, nil, 2.5, 0.7, 0.3, collision.NewDefaultFilter())
+	dyn4go.AssertTrue(t, err == nil)
+	dyn4go.AssertTrue(t, checkPolygonFixturesMass(fixtures, points, nil, 2.5) == nil)
+
+	hole := createBodyFixtureTestPolygon(0.25, 0.25, 0.75, 0.25, 0.75, 0.75, 0.25, 0.75)
+	dyn4go.AssertTrue(t, errors.Is(checkPolygonFixturesMass(fixtures, points, [][]*geometry.Vector2{hole}, 2.5), ErrMassMismatch))
+	dyn4go.AssertTrue(t, errors.Is(checkPolygonFixturesMass(fixtures[1:], points, nil, 2.5), ErrMassMismatch))
+	dyn4go.AssertTrue(t, errors.Is(checkPolygonFixturesMass(fixtures, points, nil, 2.0), ErrMassMismatch))
+}
+
+/**
+ * Tests creating fixtures from invalid polygons.
+ */
+func TestBodyFixtureCreatePolygonFixturesInvalid(t *testing.T) {
+	points := createBodyFixtureTestPolygon(0.0, 0.0, 4.0, 0.0, 4.0, 4.0, 0.0, 4.0)
+	_, err := CreatePolygonFixtures(createBodyFixtureTestPolygon(0.0, 0.0, 4.0, 4.0, 4.0, 0.0, 0.0, 4.0), nil, 1.0, 0.2, 0.0, collision.NewDefaultFilter())
+	dyn4go.AssertTrue(t, errors.Is(err, decompose.ErrSelfIntersecting))
+	_, err = CreatePolygonFixtures(points, [][]*geometry.Vector2{createBodyFixtureTestPolygon(3.0, 1.0, 5.0, 1.0, 5.0, 2.0)}, 1.0, 0.2, 0.0, collision.NewDefaultFilter())
+	dyn4go.AssertTrue(t, errors.Is(err, decompose.ErrSelfIntersecting))
+	func() {
+		defer dyn4go.AssertPanic(t)
+		CreatePolygonFixtures(points, nil, 0.0, 0.2, 0.0, collision.NewDefaultFilter())
+	}()
+}
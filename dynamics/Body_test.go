@@ -0,0 +1,189 @@
+package dynamics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests adding and removing fixtures.
+ */
+func TestBodyAddRemoveFixture(t *testing.T) {
+	b := NewBody()
+	f := b.AddFixtureConvex(geometry.CreateCircle(1.0))
+	dyn4go.AssertEqual(t, 1, b.GetFixtureCount())
+	dyn4go.AssertTrue(t, b.ContainsFixture(f))
+	dyn4go.AssertTrue(t, b.GetFixture(0) == f)
+
+	f2 := NewBodyFixture(geometry.CreateUnitCirclePolygon(5, 0.5))
+	b.AddFixture(f2)
+	dyn4go.AssertEqual(t, 2, b.GetFixtureCount())
+
+	dyn4go.AssertTrue(t, b.RemoveFixture(f))
+	dyn4go.AssertFalse(t, b.RemoveFixture(f))
+	dyn4go.AssertEqual(t, 1, b.GetFixtureCount())
+	dyn4go.AssertTrue(t, b.RemoveFixtureIndex(0) == f2)
+	dyn4go.AssertTrue(t, b.RemoveFixtureIndex(0) == nil)
+	dyn4go.AssertEqual(t, 0, b.GetFixtureCount())
+}
+
+/**
+ * Tests adding a nil fixture.
+ */
+func TestBodyAddNilFixture(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	NewBody().AddFixture(nil)
+}
+
+/**
+ * Tests the mass created from the fixtures.
+ */
+func TestBodyUpdateMass(t *testing.T) {
+	b := NewBody()
+	dyn4go.AssertTrue(t, b.GetMass().IsInfinite())
+
+	f1 := b.AddFixtureConvex(geometry.CreateUnitCirclePolygon(5, 0.5))
+	f2 := b.AddFixtureConvex(geometry.CreateCircle(1.0))
+	f2.GetShape().TranslateXY(1.0, 0.0)
+	b.UpdateMass()
+
+	m := geometry.CreateMass([]*geometry.Mass{f1.CreateMass(), f2.CreateMass()})
+	dyn4go.AssertEqual(t, geometry.NORMAL, b.GetMass().GetType())
+	dyn4go.AssertEqualWithinError(t, m.GetMass(), b.GetMass().GetMass(), 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, m.GetInertia(), b.GetMass().GetInertia(), 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, m.GetCenter().X, b.GetLocalCenter().X, 1.0e-9)
+	dyn4go.AssertTrue(t, b.GetRotationDiscRadius() > 1.0)
+
+	b.UpdateMassWithType(geometry.INFINITE)
+	dyn4go.AssertTrue(t, b.GetMass().IsInfinite())
+	dyn4go.AssertTrue(t, b.IsStatic())
+	dyn4go.AssertFalse(t, b.IsDynamic())
+}
+
+/**
+ * Tests applying forces and torques.
+ */
+func TestBodyApplyForce(t *testing.T) {
+	b := NewBody()
+	b.AddFixtureConvex(geometry.CreateCircle(1.0))
+	b.UpdateMass()
+
+	b.ApplyForce(geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertEqual(t, 1.0, b.GetForce().X)
+	dyn4go.AssertEqual(t, 0.0, b.GetTorque())
+
+	b.ApplyForceAtWorldPoint(geometry.NewVector2FromXY(0.0, 1.0), geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertEqual(t, 1.0, b.GetForce().Y)
+	dyn4go.AssertEqual(t, 1.0, b.GetTorque())
+
+	b.TranslateXY(2.0, 0.0)
+	b.ApplyForceAtLocalPoint(geometry.NewVector2FromXY(0.0, 1.0), geometry.NewVector2FromXY(-1.0, 0.0))
+	dyn4go.AssertEqual(t, 2.0, b.GetForce().Y)
+	dyn4go.AssertEqual(t, 0.0, b.GetTorque())
+
+	b.ApplyTorque(0.5)
+	dyn4go.AssertEqual(t, 0.5, b.GetTorque())
+
+	b.ClearForce()
+	b.ClearTorque()
+	dyn4go.AssertTrue(t, b.GetForce().IsZero())
+	dyn4go.AssertEqual(t, 0.0, b.GetTorque())
+}
+
+/**
+ * Tests applying impulses with the different mass types.
+ */
+func TestBodyApplyImpulse(t *testing.T) {
+	b := NewBody()
+	b.AddFixtureConvex(geometry.CreateCircle(1.0))
+	b.UpdateMass()
+	m := b.GetMass().GetMass()
+	I := b.GetMass().GetInertia()
+
+	b.ApplyImpulseAtWorldPoint(geometry.NewVector2FromXY(0.0, 1.0), geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertEqualWithinError(t, 1.0/m, b.GetVelocity().Y, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.0/I, b.GetAngularVelocity(), 1.0e-9)
+
+	b.SetVelocity(new(geometry.Vector2))
+	b.SetAngularVelocity(0)
+	b.SetMassType(geometry.FIXED_ANGULAR_VELOCITY)
+	b.ApplyImpulseAtWorldPoint(geometry.NewVector2FromXY(0.0, 1.0), geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertEqualWithinError(t, 1.0/m, b.GetVelocity().Y, 1.0e-9)
+	dyn4go.AssertEqual(t, 0.0, b.GetAngularVelocity())
+
+	b.SetVelocity(new(geometry.Vector2))
+	b.SetMassType(geometry.FIXED_LINEAR_VELOCITY)
+	b.ApplyImpulseAtWorldPoint(geometry.NewVector2FromXY(0.0, 1.0), geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertTrue(t, b.GetVelocity().IsZero())
+	dyn4go.AssertEqualWithinError(t, 1.0/I, b.GetAngularVelocity(), 1.0e-9)
+
+	b.SetAngularVelocity(0)
+	b.SetMassType(geometry.INFINITE)
+	b.ApplyImpulse(geometry.NewVector2FromXY(0.0, 1.0))
+	b.ApplyAngularImpulse(1.0)
+	dyn4go.AssertTrue(t, b.GetVelocity().IsZero())
+	dyn4go.AssertEqual(t, 0.0, b.GetAngularVelocity())
+}
+
+/**
+ * Tests putting a body to sleep and waking it with a force.
+ */
+func TestBodySleep(t *testing.T) {
+	b := NewBody()
+	b.AddFixtureConvex(geometry.CreateCircle(1.0))
+	b.UpdateMass()
+	dyn4go.AssertTrue(t, b.IsAutoSleepingEnabled())
+	dyn4go.AssertFalse(t, b.IsAsleep())
+
+	b.SetVelocity(geometry.NewVector2FromXY(1.0, 0.0))
+	b.SetAngularVelocity(1.0)
+	b.SetAsleep(true)
+	dyn4go.AssertTrue(t, b.IsAsleep())
+	dyn4go.AssertTrue(t, b.GetVelocity().IsZero())
+	dyn4go.AssertEqual(t, 0.0, b.GetAngularVelocity())
+
+	b.ApplyForce(geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertFalse(t, b.IsAsleep())
+
+	b.SetAsleep(true)
+	b.ApplyAngularImpulse(1.0)
+	dyn4go.AssertFalse(t, b.IsAsleep())
+
+	b.SetAsleep(true)
+	b.SetAutoSleepingEnabled(false)
+	dyn4go.AssertFalse(t, b.IsAsleep())
+}
+
+/**
+ * Tests the local/world conversion methods.
+ */
+func TestBodyLocalWorld(t *testing.T) {
+	b := NewBody()
+	b.AddFixtureConvex(geometry.CreateRectangle(1.0, 1.0))
+	b.UpdateMass()
+	b.TranslateXY(1.0, 1.0)
+	b.RotateAboutCenter(math.Pi / 2)
+
+	w := b.GetWorldPoint(geometry.NewVector2FromXY(0.5, 0.0))
+	dyn4go.AssertEqualWithinError(t, 1.0, w.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.5, w.Y, 1.0e-9)
+	l := b.GetLocalPoint(w)
+	dyn4go.AssertEqualWithinError(t, 0.5, l.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 0.0, l.Y, 1.0e-9)
+
+	v := b.GetWorldVector(geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertEqualWithinError(t, 0.0, v.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.0, v.Y, 1.0e-9)
+
+	b.SetAngularVelocity(1.0)
+	pv := b.GetVelocityAtWorldPoint(w)
+	dyn4go.AssertEqualWithinError(t, -0.5, pv.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 0.0, pv.Y, 1.0e-9)
+
+	aabb := b.CreateAABB()
+	dyn4go.AssertEqualWithinError(t, 0.5, aabb.GetMinX(), 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.5, aabb.GetMaxY(), 1.0e-9)
+}
@@ -0,0 +1,22 @@
+package dynamics
+
+import (
+	"math"
+)
+
+type CoefficientMixer interface {
+	MixFriction(friction1, friction2 float64) float64
+	MixRestitution(restitution1, restitution2 float64) float64
+}
+
+type DefaultCoefficientMixer struct{}
+
+var DEFAULT_MIXER CoefficientMixer = new(DefaultCoefficientMixer)
+
+func (d *DefaultCoefficientMixer) MixFriction(friction1, friction2 float64) float64 {
+	return math.Sqrt(friction1 * friction2)
+}
+
+func (d *DefaultCoefficientMixer) MixRestitution(restitution1, restitution2 float64) float64 {
+	return math.Max(restitution1, restitution2)
+}
@@ -0,0 +1,210 @@
+package dynamics
+
+type ConstraintGraphNode struct {
+	body               *Body
+	contactConstraints []*ContactConstraint
+	joints             []Jointer
+}
+
+func (n *ConstraintGraphNode) GetBody() *Body {
+	return n.body
+}
+
+func (n *ConstraintGraphNode) GetContactConstraints() []*ContactConstraint {
+	return n.contactConstraints
+}
+
+func (n *ConstraintGraphNode) GetJoints() []Jointer {
+	return n.joints
+}
+
+type ConstraintGraph struct {
+	nodes              []*ConstraintGraphNode
+	nodeMap            map[*Body]*ConstraintGraphNode
+	contactConstraints []*ContactConstraint
+	joints             []Jointer
+	islands            []*Island
+}
+
+func NewConstraintGraph() *ConstraintGraph {
+	return NewConstraintGraphInt(64)
+}
+
+func NewConstraintGraphInt(initialCapacity int) *ConstraintGraph {
+	g := new(ConstraintGraph)
+	g.nodes = make([]*ConstraintGraphNode, 0, initialCapacity)
+	g.nodeMap = make(map[*Body]*ConstraintGraphNode, initialCapacity)
+	g.contactConstraints = make([]*ContactConstraint, 0, initialCapacity)
+	g.joints = make([]Jointer, 0, initialCapacity/2)
+	g.islands = make([]*Island, 0)
+	return g
+}
+
+func (g *ConstraintGraph) AddBody(body *Body) *ConstraintGraphNode {
+	if body == nil {
+		panic("Cannot add nil body to constraint graph")
+	}
+	if node, ok := g.nodeMap[body]; ok {
+		return node
+	}
+	node := &ConstraintGraphNode{body: body}
+	g.nodes = append(g.nodes, node)
+	g.nodeMap[body] = node
+	return node
+}
+
+func (g *ConstraintGraph) AddContactConstraint(contactConstraint *ContactConstraint) {
+	if contactConstraint == nil {
+		panic("Cannot add nil contact constraint to constraint graph")
+	}
+	node1 := g.AddBody(contactConstraint.body1)
+	node2 := g.AddBody(contactConstraint.body2)
+	node1.contactConstraints = append(node1.contactConstraints, contactConstraint)
+	node2.contactConstraints = append(node2.contactConstraints, contactConstraint)
+	g.contactConstraints = append(g.contactConstraints, contactConstraint)
+}
+
+func (g *ConstraintGraph) AddJoint(joint Jointer) {
+	if joint == nil {
+		panic("Cannot add nil joint to constraint graph")
+	}
+	node1 := g.AddBody(joint.GetBody1())
+	node2 := g.AddBody(joint.GetBody2())
+	node1.joints = append(node1.joints, joint)
+	if node2 != node1 {
+		node2.joints = append(node2.joints, joint)
+	}
+	g.joints = append(g.joints, joint)
+}
+
+func (g *ConstraintGraph) GetNode(body *Body) *ConstraintGraphNode {
+	return g.nodeMap[body]
+}
+
+func (g *ConstraintGraph) GetNodes() []*ConstraintGraphNode {
+	return g.nodes
+}
+
+func (g *ConstraintGraph) GetNodeCount() int {
+	return len(g.nodes)
+}
+
+func (g *ConstraintGraph) Clear() {
+	g.nodes = g.nodes[:0]
+	for body := range g.nodeMap {
+		delete(g.nodeMap, body)
+	}
+	g.contactConstraints = g.contactConstraints[:0]
+	g.joints = g.joints[:0]
+	g.islands = g.islands[:0]
+}
+
+func (g *ConstraintGraph) FindIslands() []*Island {
+	g.wakeKinematicContacts()
+	g.islands = g.islands[:0]
+	visitedNodes := make(map[*ConstraintGraphNode]bool, len(g.nodes))
+	visitedJoints := make(map[Jointer]bool, len(g.joints))
+	visitedContactConstraints := make(map[*ContactConstraint]bool, len(g.contactConstraints))
+	stack := make([]*ConstraintGraphNode, 0, len(g.nodes))
+	for _, seed := range g.nodes {
+		if visitedNodes[seed] || !seed.body.IsDynamic() || seed.body.asleep {
+			continue
+		}
+		island := NewIsland()
+		visitedNodes[seed] = true
+		stack = append(stack[:0], seed)
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			body := node.body
+			island.addBody(body)
+			if !body.IsDynamic() {
+				continue
+			}
+			if body.asleep {
+				body.SetAsleep(false)
+			}
+			for _, contactConstraint := range node.contactConstraints {
+				if visitedContactConstraints[contactConstraint] {
+					continue
+				}
+				visitedContactConstraints[contactConstraint] = true
+				island.addContactConstraint(contactConstraint)
+				other := g.nodeMap[contactConstraint.body1]
+				if other == node {
+					other = g.nodeMap[contactConstraint.body2]
+				}
+				if !visitedNodes[other] {
+					visitedNodes[other] = true
+					stack = append(stack, other)
+				}
+			}
+			for _, joint := range node.joints {
+				if visitedJoints[joint] {
+					continue
+				}
+				visitedJoints[joint] = true
+				island.addJoint(joint)
+				other := g.nodeMap[body.getOtherBody(joint)]
+				if !visitedNodes[other] {
+					visitedNodes[other] = true
+					stack = append(stack, other)
+				}
+			}
+		}
+		for _, body := range island.bodies {
+			if !body.IsDynamic() {
+				visitedNodes[g.nodeMap[body]] = false
+			}
+		}
+		g.islands = append(g.islands, island)
+	}
+	return g.islands
+}
+
+func (g *ConstraintGraph) wakeKinematicContacts() {
+	for _, contactConstraint := range g.contactConstraints {
+		wakeKinematicContact(contactConstraint.body1, contactConstraint.body2)
+	}
+	for _, joint := range g.joints {
+		wakeKinematicContact(joint.GetBody1(), joint.GetBody2())
+	}
+}
+
+func wakeKinematicContact(body1, body2 *Body) {
+	if body1.IsKinematic() && body2.asleep {
+		body2.SetAsleep(false)
+	} else if body2.IsKinematic() && body1.asleep {
+		body1.SetAsleep(false)
+	}
+}
+
+func (g *ConstraintGraph) GetIslands() []*Island {
+	return g.islands
+}
+
+func (g *ConstraintGraph) GetIslandCount() int {
+	return len(g.islands)
+}
+
+func (g *ConstraintGraph) GetIslandIterator() *IslandIterator {
+	return &IslandIterator{islands: g.islands}
+}
+
+type IslandIterator struct {
+	islands []*Island
+	index   int
+}
+
+func (it *IslandIterator) HasNext() bool {
+	return it.index < len(it.islands)
+}
+
+func (it *IslandIterator) Next() *Island {
+	if !it.HasNext() {
+		panic("No more islands")
+	}
+	island := it.islands[it.index]
+	it.index++
+	return island
+}
@@ -0,0 +1,121 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests adding nodes and edges to a constraint graph.
+ */
+func TestConstraintGraphAdd(t *testing.T) {
+	g := NewConstraintGraph()
+	b1 := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	n1 := g.AddBody(b1)
+	dyn4go.AssertTrue(t, n1 == g.AddBody(b1))
+	dyn4go.AssertTrue(t, n1.GetBody() == b1)
+
+	j := NewDistanceJoint(b1, b2, b1.GetWorldCenter(), b2.GetWorldCenter())
+	g.AddJoint(j)
+	dyn4go.AssertEqual(t, 2, g.GetNodeCount())
+	dyn4go.AssertEqual(t, 1, len(n1.GetJoints()))
+	dyn4go.AssertEqual(t, 1, len(g.GetNode(b2).GetJoints()))
+
+	islands := g.FindIslands()
+	dyn4go.AssertEqual(t, 1, len(islands))
+	dyn4go.AssertEqual(t, 2, len(islands[0].GetBodies()))
+	dyn4go.AssertEqual(t, 1, len(islands[0].GetJoints()))
+
+	g.Clear()
+	dyn4go.AssertEqual(t, 0, g.GetNodeCount())
+	dyn4go.AssertEqual(t, 0, g.GetIslandCount())
+	dyn4go.AssertTrue(t, g.GetNode(b1) == nil)
+}
+
+/**
+ * Tests adding a nil body to a constraint graph.
+ */
+func TestConstraintGraphAddNilBody(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	g := NewConstraintGraph()
+	g.AddBody(nil)
+}
+
+/**
+ * Tests that static bodies separate islands.
+ */
+func TestConstraintGraphStaticBoundary(t *testing.T) {
+	w, floor := createSolverTestWorld()
+	boxes := make([]*Body, 4)
+	for i := range boxes {
+		boxes[i] = createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+		boxes[i].TranslateXY(float64(i/2)*4.0-2.0, float64(i%2)+1.0)
+		w.AddBody(boxes[i])
+	}
+	w.StepN(10)
+
+	g := w.GetConstraintGraph()
+	dyn4go.AssertEqual(t, 5, g.GetNodeCount())
+	dyn4go.AssertEqual(t, 2, len(g.GetNode(floor).GetContactConstraints()))
+	dyn4go.AssertEqual(t, 2, g.GetIslandCount())
+
+	it := g.GetIslandIterator()
+	count := 0
+	for it.HasNext() {
+		island := it.Next()
+		bodies := island.GetBodies()
+		dyn4go.AssertEqual(t, 3, len(bodies))
+		dyn4go.AssertEqual(t, 2, len(island.GetContactConstraints()))
+		floors := 0
+		for _, body := range bodies {
+			if body == floor {
+				floors++
+			}
+		}
+		dyn4go.AssertEqual(t, 1, floors)
+		count++
+	}
+	dyn4go.AssertEqual(t, 2, count)
+
+	w.AddJoint(NewDistanceJoint(boxes[1], boxes[3], boxes[1].GetWorldCenter(), boxes[3].GetWorldCenter()))
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 1, g.GetIslandCount())
+	island := g.GetIslands()[0]
+	dyn4go.AssertEqual(t, 5, len(island.GetBodies()))
+	dyn4go.AssertEqual(t, 4, len(island.GetContactConstraints()))
+	dyn4go.AssertEqual(t, 1, len(island.GetJoints()))
+}
+
+/**
+ * Tests that sleeping islands are not iterated.
+ */
+func TestConstraintGraphSleepingIslands(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	boxes := make([]*Body, 4)
+	for i := range boxes {
+		boxes[i] = createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+		boxes[i].TranslateXY(float64(i/2)*4.0-2.0, float64(i%2)+1.0)
+		w.AddBody(boxes[i])
+	}
+	w.StepN(130)
+	dyn4go.AssertTrue(t, boxes[0].IsAsleep())
+	dyn4go.AssertEqual(t, 0, w.GetConstraintGraph().GetIslandCount())
+
+	boxes[3].ApplyImpulse(geometry.NewVector2FromXY(0.0, 1.0))
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 1, w.GetConstraintGraph().GetIslandCount())
+	dyn4go.AssertTrue(t, boxes[0].IsAsleep())
+	dyn4go.AssertFalse(t, boxes[2].IsAsleep())
+}
+
+/**
+ * Tests iterating past the last island.
+ */
+func TestConstraintGraphIteratorEnd(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	g := NewConstraintGraph()
+	g.GetIslandIterator().Next()
+}
@@ -0,0 +1,60 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type Contact struct {
+	id     interface{}
+	p      *geometry.Vector2
+	depth  float64
+	p1, p2 *geometry.Vector2
+	r1, r2 *geometry.Vector2
+	jn, jt float64
+	massN  float64
+	massT  float64
+	vb     float64
+	state  int
+}
+
+func NewContact(id interface{}, point *geometry.Vector2, depth float64, localPoint1, localPoint2 *geometry.Vector2) *Contact {
+	c := new(Contact)
+	c.id = id
+	c.p = point
+	c.depth = depth
+	c.p1 = localPoint1
+	c.p2 = localPoint2
+	return c
+}
+
+func (c *Contact) GetID() interface{} {
+	return c.id
+}
+
+func (c *Contact) GetPoint() *geometry.Vector2 {
+	return c.p
+}
+
+func (c *Contact) GetDepth() float64 {
+	return c.depth
+}
+
+func (c *Contact) GetLocalPoint1() *geometry.Vector2 {
+	return c.p1
+}
+
+func (c *Contact) GetLocalPoint2() *geometry.Vector2 {
+	return c.p2
+}
+
+func (c *Contact) GetNormalImpulse() float64 {
+	return c.jn
+}
+
+func (c *Contact) GetTangentImpulse() float64 {
+	return c.jt
+}
+
+func (c *Contact) GetState() int {
+	return c.state
+}
@@ -0,0 +1,107 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/collision/manifold"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type ContactConstraint struct {
+	id                 ContactConstraintID
+	body1, body2       *Body
+	fixture1, fixture2 *BodyFixture
+	contacts           []*Contact
+	normal, tangent    *geometry.Vector2
+	friction           float64
+	restitution        float64
+	sensor             bool
+	enabled            bool
+	K, invK            *geometry.Matrix22
+}
+
+func NewContactConstraint(body1 *Body, fixture1 *BodyFixture, body2 *Body, fixture2 *BodyFixture, manifold *manifold.Manifold, mixer CoefficientMixer) *ContactConstraint {
+	c := new(ContactConstraint)
+	c.id = NewContactConstraintID(fixture1, fixture2)
+	c.body1 = body1
+	c.fixture1 = fixture1
+	c.body2 = body2
+	c.fixture2 = fixture2
+	points := manifold.GetPoints()
+	c.contacts = make([]*Contact, len(points))
+	for i, mp := range points {
+		p := mp.GetPoint()
+		c.contacts[i] = NewContact(mp.GetID(), p, mp.GetDepth(), body1.GetLocalPoint(p), body2.GetLocalPoint(p))
+	}
+	c.normal = manifold.GetNormal()
+	c.tangent = c.normal.CrossZ(1)
+	c.friction = mixer.MixFriction(fixture1.GetFriction(), fixture2.GetFriction())
+	c.restitution = mixer.MixRestitution(fixture1.GetRestitution(), fixture2.GetRestitution())
+	c.sensor = fixture1.IsSensor() || fixture2.IsSensor()
+	c.enabled = true
+	return c
+}
+
+func (c *ContactConstraint) GetID() ContactConstraintID {
+	return c.id
+}
+
+func (c *ContactConstraint) GetBody1() *Body {
+	return c.body1
+}
+
+func (c *ContactConstraint) GetBody2() *Body {
+	return c.body2
+}
+
+func (c *ContactConstraint) GetFixture1() *BodyFixture {
+	return c.fixture1
+}
+
+func (c *ContactConstraint) GetFixture2() *BodyFixture {
+	return c.fixture2
+}
+
+func (c *ContactConstraint) GetContacts() []*Contact {
+	return c.contacts
+}
+
+func (c *ContactConstraint) GetNormal() *geometry.Vector2 {
+	return c.normal
+}
+
+func (c *ContactConstraint) GetTangent() *geometry.Vector2 {
+	return c.tangent
+}
+
+func (c *ContactConstraint) GetFriction() float64 {
+	return c.friction
+}
+
+func (c *ContactConstraint) SetFriction(friction float64) {
+	if friction < 0 {
+		panic("Friction must not be negative")
+	}
+	c.friction = friction
+}
+
+func (c *ContactConstraint) GetRestitution() float64 {
+	return c.restitution
+}
+
+func (c *ContactConstraint) SetRestitution(restitution float64) {
+	if restitution < 0 {
+		panic("Restitution must not be negative")
+	}
+	c.restitution = restitution
+}
+
+func (c *ContactConstraint) IsSensor() bool {
+	return c.sensor
+}
+
+func (c *ContactConstraint) IsEnabled() bool {
+	return c.enabled
+}
+
+func (c *ContactConstraint) SetEnabled(flag bool) {
+	c.enabled = flag
+}
@@ -0,0 +1,22 @@
+package dynamics
+
+type ContactConstraintID struct {
+	fixture1, fixture2 string
+}
+
+func NewContactConstraintID(fixture1, fixture2 *BodyFixture) ContactConstraintID {
+	id1 := fixture1.GetID()
+	id2 := fixture2.GetID()
+	if id2 < id1 {
+		id1, id2 = id2, id1
+	}
+	return ContactConstraintID{id1, id2}
+}
+
+func (c ContactConstraintID) GetFixtureID1() string {
+	return c.fixture1
+}
+
+func (c ContactConstraintID) GetFixtureID2() string {
+	return c.fixture2
+}
@@ -0,0 +1,11 @@
+package dynamics
+
+type ContactConstraintSolver interface {
+	Initialize(contactConstraints []*ContactConstraint, step *Step, settings *Settings)
+	SolveVelocityConstraints(contactConstraints []*ContactConstraint, step *Step, settings *Settings)
+	SolvePositionConstraints(contactConstraints []*ContactConstraint, step *Step, settings *Settings) bool
+}
+
+type ContactConstraintSolverCloner interface {
+	Clone() ContactConstraintSolver
+}
@@ -0,0 +1,37 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go"
+)
+
+type ContactListener interface {
+	dyn4go.Listener
+	Sensed(point *ContactPoint)
+	Begin(point *ContactPoint) bool
+	Persist(point *ContactPoint) bool
+	End(point *ContactPoint)
+	PreSolve(point *ContactPoint) bool
+	PostSolve(point *SolvedContactPoint)
+}
+
+type ContactAdapter struct{}
+
+var _ ContactListener = new(ContactAdapter)
+
+func (c *ContactAdapter) Sensed(point *ContactPoint) {}
+
+func (c *ContactAdapter) Begin(point *ContactPoint) bool {
+	return true
+}
+
+func (c *ContactAdapter) Persist(point *ContactPoint) bool {
+	return true
+}
+
+func (c *ContactAdapter) End(point *ContactPoint) {}
+
+func (c *ContactAdapter) PreSolve(point *ContactPoint) bool {
+	return true
+}
+
+func (c *ContactAdapter) PostSolve(point *SolvedContactPoint) {}
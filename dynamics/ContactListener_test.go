@@ -0,0 +1,129 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type contactListenerTestRecorder struct {
+	ContactAdapter
+	sensed, begun, persisted, ended int
+	preSolved, postSolved           int
+	normalImpulse                   float64
+	allowBegin, allowPreSolve       bool
+	friction                        float64
+}
+
+func newContactListenerTestRecorder() *contactListenerTestRecorder {
+	c := new(contactListenerTestRecorder)
+	c.allowBegin = true
+	c.allowPreSolve = true
+	c.friction = -1
+	return c
+}
+
+func (c *contactListenerTestRecorder) Sensed(point *ContactPoint) {
+	c.sensed++
+}
+
+func (c *contactListenerTestRecorder) Begin(point *ContactPoint) bool {
+	c.begun++
+	return c.allowBegin
+}
+
+func (c *contactListenerTestRecorder) Persist(point *ContactPoint) bool {
+	c.persisted++
+	return true
+}
+
+func (c *contactListenerTestRecorder) End(point *ContactPoint) {
+	c.ended++
+}
+
+func (c *contactListenerTestRecorder) PreSolve(point *ContactPoint) bool {
+	c.preSolved++
+	if c.friction >= 0 {
+		point.GetContactConstraint().SetFriction(c.friction)
+	}
+	return c.allowPreSolve
+}
+
+func (c *contactListenerTestRecorder) PostSolve(point *SolvedContactPoint) {
+	c.postSolved++
+	c.normalImpulse += point.GetNormalImpulse()
+}
+
+func createListenerTestWorld() (*World, *Body, *contactListenerTestRecorder) {
+	w, _ := createSolverTestWorld()
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.TranslateXY(0.0, 0.95)
+	w.AddBody(box)
+	listener := newContactListenerTestRecorder()
+	w.AddListener(listener)
+	return w, box, listener
+}
+
+/**
+ * Tests the begin, persist, end, pre-solve and post-solve notifications.
+ */
+func TestContactListenerNotifications(t *testing.T) {
+	w, box, listener := createListenerTestWorld()
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 2, listener.begun)
+	dyn4go.AssertEqual(t, 2, listener.persisted)
+	dyn4go.AssertEqual(t, 0, listener.ended)
+	dyn4go.AssertEqual(t, 2, listener.preSolved)
+	dyn4go.AssertEqual(t, 2, listener.postSolved)
+	dyn4go.AssertTrue(t, listener.normalImpulse > 0)
+	dyn4go.AssertEqual(t, 0, listener.sensed)
+
+	w.RemoveBody(box)
+	dyn4go.AssertEqual(t, 2, listener.ended)
+
+	dyn4go.AssertTrue(t, w.RemoveListener(listener))
+	dyn4go.AssertFalse(t, w.RemoveListener(listener))
+	dyn4go.AssertEqual(t, 0, len(w.GetContactListeners()))
+}
+
+/**
+ * Tests disabling contacts from the begin and pre-solve callbacks.
+ */
+func TestContactListenerDisable(t *testing.T) {
+	w, box, listener := createListenerTestWorld()
+	listener.allowBegin = false
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 0, listener.preSolved)
+	dyn4go.AssertEqual(t, 0, listener.postSolved)
+
+	listener.allowPreSolve = false
+	w.StepN(30)
+	dyn4go.AssertEqual(t, 0, listener.postSolved)
+	dyn4go.AssertTrue(t, box.GetTransform().Y < 0.5)
+}
+
+/**
+ * Tests modifying the friction of a contact from the pre-solve callback.
+ */
+func TestContactListenerModify(t *testing.T) {
+	w, box, listener := createListenerTestWorld()
+	listener.friction = 0
+	box.SetVelocity(geometry.NewVector2FromXY(2.0, 0.0))
+	w.StepN(30)
+	dyn4go.AssertEqualWithinError(t, 2.0, box.GetVelocity().X, 1.0e-3)
+	dyn4go.AssertTrue(t, listener.preSolved > 0)
+}
+
+/**
+ * Tests that sensor fixtures are notified but not solved.
+ */
+func TestContactListenerSensor(t *testing.T) {
+	w, box, listener := createListenerTestWorld()
+	box.GetBodyFixture(0).SetSensor(true)
+	w.StepN(30)
+	dyn4go.AssertTrue(t, listener.sensed > 0)
+	dyn4go.AssertEqual(t, 0, listener.preSolved)
+	dyn4go.AssertEqual(t, 0, listener.postSolved)
+	dyn4go.AssertTrue(t, box.GetTransform().Y < 0.5)
+}
@@ -0,0 +1,148 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/collision/manifold"
+)
+
+type ContactManager struct {
+	constraints   []*ContactConstraint
+	constraintMap map[ContactConstraintID]*ContactConstraint
+	queue         []*ContactConstraint
+	contactPoints []*ContactPoint
+}
+
+func NewContactManager() *ContactManager {
+	return NewContactManagerInt(64)
+}
+
+func NewContactManagerInt(initialCapacity int) *ContactManager {
+	c := new(ContactManager)
+	c.constraints = make([]*ContactConstraint, 0, initialCapacity)
+	c.constraintMap = make(map[ContactConstraintID]*ContactConstraint, initialCapacity)
+	c.queue = make([]*ContactConstraint, 0, initialCapacity)
+	c.contactPoints = make([]*ContactPoint, 0, initialCapacity)
+	return c
+}
+
+func (c *ContactManager) Queue(contactConstraint *ContactConstraint) {
+	if contactConstraint == nil {
+		panic("Cannot queue nil contact constraint")
+	}
+	c.queue = append(c.queue, contactConstraint)
+}
+
+func (c *ContactManager) UpdateContacts(settings *Settings) {
+	c.contactPoints = c.contactPoints[:0]
+	warmStartDistanceSquared := settings.GetWarmStartDistanceSquared()
+	warm := settings.IsWarmStartingEnabled()
+	constraintMap := make(map[ContactConstraintID]*ContactConstraint, len(c.queue))
+	for _, newConstraint := range c.queue {
+		constraintMap[newConstraint.id] = newConstraint
+		oldConstraint, ok := c.constraintMap[newConstraint.id]
+		if !ok {
+			for _, contact := range newConstraint.contacts {
+				contact.state = CONTACT_POINT_BEGIN
+				c.contactPoints = append(c.contactPoints, newContactPoint(CONTACT_POINT_BEGIN, newConstraint, contact))
+			}
+			continue
+		}
+		delete(c.constraintMap, newConstraint.id)
+		single := len(oldConstraint.contacts) == 1 && len(newConstraint.contacts) == 1
+		matched := make([]bool, len(oldConstraint.contacts))
+		for _, newContact := range newConstraint.contacts {
+			found := false
+			for k, oldContact := range oldConstraint.contacts {
+				if matched[k] || !contactsMatch(newContact, oldContact, single, warmStartDistanceSquared) {
+					continue
+				}
+				if warm {
+					newContact.jn = oldContact.jn
+					newContact.jt = oldContact.jt
+				}
+				newContact.state = CONTACT_POINT_PERSIST
+				point := newContactPoint(CONTACT_POINT_PERSIST, newConstraint, newContact)
+				point.oldPoint = oldContact.p
+				point.oldNormal = oldConstraint.normal
+				point.oldDepth = oldContact.depth
+				c.contactPoints = append(c.contactPoints, point)
+				matched[k] = true
+				found = true
+				break
+			}
+			if !found {
+				newContact.state = CONTACT_POINT_BEGIN
+				c.contactPoints = append(c.contactPoints, newContactPoint(CONTACT_POINT_BEGIN, newConstraint, newContact))
+			}
+		}
+		for k, oldContact := range oldConstraint.contacts {
+			if !matched[k] {
+				oldContact.state = CONTACT_POINT_END
+				c.contactPoints = append(c.contactPoints, newContactPoint(CONTACT_POINT_END, oldConstraint, oldContact))
+			}
+		}
+	}
+	for _, oldConstraint := range c.constraints {
+		if _, ok := c.constraintMap[oldConstraint.id]; ok {
+			c.endContactConstraint(oldConstraint)
+		}
+	}
+	c.constraints, c.queue = c.queue, c.constraints[:0]
+	c.constraintMap = constraintMap
+}
+
+func contactsMatch(newContact, oldContact *Contact, single bool, warmStartDistanceSquared float64) bool {
+	if id, ok := newContact.id.(*manifold.IndexedManifoldPointId); ok {
+		return id.Equals(oldContact.id)
+	}
+	if newContact.id == manifold.DISTANCE && oldContact.id == manifold.DISTANCE {
+		return single || newContact.p.DistanceSquaredFromVector2(oldContact.p) <= warmStartDistanceSquared
+	}
+	return false
+}
+
+func (c *ContactManager) endContactConstraint(contactConstraint *ContactConstraint) {
+	for _, contact := range contactConstraint.contacts {
+		contact.state = CONTACT_POINT_END
+		c.contactPoints = append(c.contactPoints, newContactPoint(CONTACT_POINT_END, contactConstraint, contact))
+	}
+}
+
+func (c *ContactManager) EndBody(body *Body) {
+	c.contactPoints = c.contactPoints[:0]
+	constraints := c.constraints[:0]
+	for _, contactConstraint := range c.constraints {
+		if contactConstraint.body1 == body || contactConstraint.body2 == body {
+			delete(c.constraintMap, contactConstraint.id)
+			c.endContactConstraint(contactConstraint)
+		} else {
+			constraints = append(constraints, contactConstraint)
+		}
+	}
+	c.constraints = constraints
+}
+
+func (c *ContactManager) Clear() {
+	c.contactPoints = c.contactPoints[:0]
+	for _, contactConstraint := range c.constraints {
+		c.endContactConstraint(contactConstraint)
+	}
+	c.constraints = c.constraints[:0]
+	c.constraintMap = make(map[ContactConstraintID]*ContactConstraint)
+	c.queue = c.queue[:0]
+}
+
+func (c *ContactManager) GetContactConstraints() []*ContactConstraint {
+	return c.constraints
+}
+
+func (c *ContactManager) GetContactConstraint(id ContactConstraintID) *ContactConstraint {
+	return c.constraintMap[id]
+}
+
+func (c *ContactManager) GetContactPoints() []*ContactPoint {
+	return c.contactPoints
+}
+
+func (c *ContactManager) IsEmpty() bool {
+	return len(c.constraints) == 0
+}
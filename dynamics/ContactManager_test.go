@@ -0,0 +1,118 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/collision/manifold"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func countContactPoints(manager *ContactManager, state int) int {
+	count := 0
+	for _, point := range manager.GetContactPoints() {
+		if point.GetState() == state {
+			count++
+		}
+	}
+	return count
+}
+
+/**
+ * Tests that contact constraint ids do not depend on fixture order.
+ */
+func TestContactConstraintID(t *testing.T) {
+	f1 := NewBodyFixture(geometry.CreateCircle(1.0))
+	f2 := NewBodyFixture(geometry.CreateCircle(1.0))
+	f3 := NewBodyFixture(geometry.CreateCircle(1.0))
+	dyn4go.AssertTrue(t, NewContactConstraintID(f1, f2) == NewContactConstraintID(f2, f1))
+	dyn4go.AssertFalse(t, NewContactConstraintID(f1, f2) == NewContactConstraintID(f1, f3))
+}
+
+/**
+ * Tests that contact points begin, persist and end.
+ */
+func TestContactManagerStates(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.TranslateXY(0.0, 0.95)
+	w.AddBody(box)
+	manager := w.GetContactManager()
+
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 1, len(manager.GetContactConstraints()))
+	dyn4go.AssertEqual(t, 0, countContactPoints(manager, CONTACT_POINT_BEGIN))
+	dyn4go.AssertEqual(t, 2, countContactPoints(manager, CONTACT_POINT_PERSIST))
+	dyn4go.AssertEqual(t, 0, countContactPoints(manager, CONTACT_POINT_END))
+	for _, point := range manager.GetContactPoints() {
+		dyn4go.AssertTrue(t, point.GetOldPoint() != nil)
+		dyn4go.AssertTrue(t, point.GetBody1() == box || point.GetBody2() == box)
+	}
+	id := manager.GetContactConstraints()[0].GetID()
+	dyn4go.AssertTrue(t, manager.GetContactConstraint(id) != nil)
+
+	box.TranslateXY(0.0, 5.0)
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 0, len(manager.GetContactConstraints()))
+	dyn4go.AssertEqual(t, 2, countContactPoints(manager, CONTACT_POINT_END))
+	dyn4go.AssertTrue(t, manager.GetContactConstraint(id) == nil)
+
+	box.TranslateXY(0.0, -5.0)
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 2, countContactPoints(manager, CONTACT_POINT_BEGIN))
+	dyn4go.AssertEqual(t, 0, countContactPoints(manager, CONTACT_POINT_PERSIST))
+}
+
+/**
+ * Tests that removing a body ends its contacts.
+ */
+func TestContactManagerEndBody(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.TranslateXY(0.0, 0.95)
+	w.AddBody(box)
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	manager := w.GetContactManager()
+	dyn4go.AssertFalse(t, manager.IsEmpty())
+
+	w.RemoveBody(box)
+	dyn4go.AssertTrue(t, manager.IsEmpty())
+	dyn4go.AssertEqual(t, 2, countContactPoints(manager, CONTACT_POINT_END))
+}
+
+func createContactManagerTestConstraint(body1 *Body, body2 *Body, normal *geometry.Vector2) *ContactConstraint {
+	point := manifold.NewManifoldPointInterfaceVector2Float64(manifold.DISTANCE, geometry.NewVector2FromXY(0.0, 0.5), 0.05)
+	m := manifold.NewManifoldManifoldPointsVector2([]*manifold.ManifoldPoint{point}, normal)
+	return NewContactConstraint(body1, body1.GetBodyFixture(0), body2, body2.GetBodyFixture(0), m, DEFAULT_MIXER)
+}
+
+/**
+ * Tests that warm starting impulses carry over unchanged when the fixture
+ * order of a contact constraint swaps.
+ */
+func TestContactManagerWarmStartFlipped(t *testing.T) {
+	settings := NewSettings()
+	b1 := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	manager := NewContactManager()
+
+	c1 := createContactManagerTestConstraint(b1, b2, geometry.NewVector2FromXY(0.0, -1.0))
+	manager.Queue(c1)
+	manager.UpdateContacts(settings)
+	c1.contacts[0].jn = 2.0
+	c1.contacts[0].jt = 0.5
+
+	c2 := createContactManagerTestConstraint(b2, b1, geometry.NewVector2FromXY(0.0, 1.0))
+	manager.Queue(c2)
+	manager.UpdateContacts(settings)
+	dyn4go.AssertEqual(t, 2.0, c2.contacts[0].jn)
+	dyn4go.AssertEqual(t, 0.5, c2.contacts[0].jt)
+	dyn4go.AssertTrue(t, c2.tangent.DotVector2(c1.tangent) < 0)
+
+	settings.SetWarmStartingEnabled(false)
+	c3 := createContactManagerTestConstraint(b1, b2, geometry.NewVector2FromXY(0.0, -1.0))
+	manager.Queue(c3)
+	manager.UpdateContacts(settings)
+	dyn4go.AssertEqual(t, 0.0, c3.contacts[0].jn)
+	dyn4go.AssertEqual(t, 0.0, c3.contacts[0].jt)
+}
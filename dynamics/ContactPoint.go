@@ -0,0 +1,110 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+const (
+	CONTACT_POINT_BEGIN = iota
+	CONTACT_POINT_PERSIST
+	CONTACT_POINT_END
+)
+
+type ContactPointID struct {
+	contactConstraintID ContactConstraintID
+	manifoldPointID     interface{}
+}
+
+func (c ContactPointID) GetContactConstraintID() ContactConstraintID {
+	return c.contactConstraintID
+}
+
+func (c ContactPointID) GetManifoldPointID() interface{} {
+	return c.manifoldPointID
+}
+
+type ContactPoint struct {
+	id                 ContactPointID
+	state              int
+	body1, body2       *Body
+	fixture1, fixture2 *BodyFixture
+	point, normal      *geometry.Vector2
+	depth              float64
+	oldPoint           *geometry.Vector2
+	oldNormal          *geometry.Vector2
+	oldDepth           float64
+	sensor             bool
+	contactConstraint  *ContactConstraint
+}
+
+func newContactPoint(state int, contactConstraint *ContactConstraint, contact *Contact) *ContactPoint {
+	c := new(ContactPoint)
+	c.id = ContactPointID{contactConstraint.id, contact.id}
+	c.state = state
+	c.body1 = contactConstraint.body1
+	c.fixture1 = contactConstraint.fixture1
+	c.body2 = contactConstraint.body2
+	c.fixture2 = contactConstraint.fixture2
+	c.point = contact.p
+	c.normal = contactConstraint.normal
+	c.depth = contact.depth
+	c.sensor = contactConstraint.sensor
+	c.contactConstraint = contactConstraint
+	return c
+}
+
+func (c *ContactPoint) GetID() ContactPointID {
+	return c.id
+}
+
+func (c *ContactPoint) GetState() int {
+	return c.state
+}
+
+func (c *ContactPoint) GetBody1() *Body {
+	return c.body1
+}
+
+func (c *ContactPoint) GetFixture1() *BodyFixture {
+	return c.fixture1
+}
+
+func (c *ContactPoint) GetBody2() *Body {
+	return c.body2
+}
+
+func (c *ContactPoint) GetFixture2() *BodyFixture {
+	return c.fixture2
+}
+
+func (c *ContactPoint) GetPoint() *geometry.Vector2 {
+	return c.point
+}
+
+func (c *ContactPoint) GetNormal() *geometry.Vector2 {
+	return c.normal
+}
+
+func (c *ContactPoint) GetDepth() float64 {
+	return c.depth
+}
+
+func (c *ContactPoint) GetOldPoint() *geometry.Vector2 {
+	return c.oldPoint
+}
+
+func (c *ContactPoint) GetOldNormal() *geometry.Vector2 {
+	return c.oldNormal
+}
+
+func (c *ContactPoint) GetOldDepth() float64 {
+	return c.oldDepth
+}
+
+func (c *ContactPoint) IsSensor() bool {
+	return c.sensor
+}
+
+func (c *ContactPoint) GetContactConstraint() *ContactConstraint {
+	return c.contactConstraint
+}
@@ -0,0 +1,44 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/collision/continuous"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type ConvexCastResult struct {
+	body         *Body
+	fixture      *BodyFixture
+	timeOfImpact *continuous.TimeOfImpact
+}
+
+func NewConvexCastResult(body *Body, fixture *BodyFixture, timeOfImpact *continuous.TimeOfImpact) *ConvexCastResult {
+	c := new(ConvexCastResult)
+	c.body = body
+	c.fixture = fixture
+	c.timeOfImpact = timeOfImpact
+	return c
+}
+
+func (c *ConvexCastResult) GetBody() *Body {
+	return c.body
+}
+
+func (c *ConvexCastResult) GetFixture() *BodyFixture {
+	return c.fixture
+}
+
+func (c *ConvexCastResult) GetTimeOfImpact() *continuous.TimeOfImpact {
+	return c.timeOfImpact
+}
+
+func (c *ConvexCastResult) GetTime() float64 {
+	return c.timeOfImpact.GetTime()
+}
+
+func (c *ConvexCastResult) GetPoint() *geometry.Vector2 {
+	return c.timeOfImpact.GetSeparation().GetPoint2()
+}
+
+func (c *ConvexCastResult) GetNormal() *geometry.Vector2 {
+	return c.timeOfImpact.GetSeparation().GetNormal().GetNegative()
+}
@@ -0,0 +1,21 @@
+package dynamics
+
+type DetectResult struct {
+	body    *Body
+	fixture *BodyFixture
+}
+
+func NewDetectResult(body *Body, fixture *BodyFixture) *DetectResult {
+	d := new(DetectResult)
+	d.body = body
+	d.fixture = fixture
+	return d
+}
+
+func (d *DetectResult) GetBody() *Body {
+	return d.body
+}
+
+func (d *DetectResult) GetFixture() *BodyFixture {
+	return d.fixture
+}
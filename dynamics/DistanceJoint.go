@@ -0,0 +1,185 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type DistanceJoint struct {
+	Joint
+	localAnchor1, localAnchor2 *geometry.Vector2
+	distance                   float64
+	frequency                  float64
+	dampingRatio               float64
+	invK                       float64
+	gamma                      float64
+	bias                       float64
+	impulse                    float64
+	n                          *geometry.Vector2
+}
+
+var _ Jointer = new(DistanceJoint)
+
+func NewDistanceJoint(body1, body2 *Body, anchor1, anchor2 *geometry.Vector2) *DistanceJoint {
+	if body1 == body2 {
+		panic("Cannot create a distance joint between the same body")
+	}
+	if anchor1 == nil || anchor2 == nil {
+		panic("Cannot create a distance joint with a nil anchor")
+	}
+	d := new(DistanceJoint)
+	InitJoint(&d.Joint, body1, body2, false)
+	d.localAnchor1 = body1.GetLocalPoint(anchor1)
+	d.localAnchor2 = body2.GetLocalPoint(anchor2)
+	d.distance = anchor1.DistanceFromVector2(anchor2)
+	d.n = new(geometry.Vector2)
+	return d
+}
+
+func (d *DistanceJoint) InitializeConstraints(step *Step, settings *Settings) {
+	linearTolerance := settings.GetLinearTolerance()
+	invM1 := d.body1.mass.GetInverseMass()
+	invM2 := d.body2.mass.GetInverseMass()
+	invI1 := d.body1.mass.GetInverseInertia()
+	invI2 := d.body2.mass.GetInverseInertia()
+	dt := step.GetDeltaTime()
+
+	r1 := d.getR1(d.localAnchor1)
+	r2 := d.getR2(d.localAnchor2)
+	d.n = d.body1.GetWorldCenter().AddVector2(r1).HereToVector2(d.body2.GetWorldCenter().AddVector2(r2))
+	length := d.n.GetMagnitude()
+	if length > linearTolerance {
+		d.n.Multiply(1 / length)
+	} else {
+		d.n.Zero()
+	}
+
+	cr1n := r1.CrossVector2(d.n)
+	cr2n := r2.CrossVector2(d.n)
+	invMass := invM1 + invI1*cr1n*cr1n + invM2 + invI2*cr2n*cr2n
+	d.invK = 0
+	if invMass != 0 {
+		d.invK = 1 / invMass
+	}
+
+	d.gamma = 0
+	d.bias = 0
+	if d.frequency > 0 {
+		C := length - d.distance
+		omega := geometry.TWO_PI * d.frequency
+		dc := 2 * d.invK * d.dampingRatio * omega
+		k := d.invK * omega * omega
+		d.gamma = dt * (dc + dt*k)
+		if d.gamma != 0 {
+			d.gamma = 1 / d.gamma
+		}
+		d.bias = C * dt * k * d.gamma
+		invMass += d.gamma
+		d.invK = 0
+		if invMass != 0 {
+			d.invK = 1 / invMass
+		}
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		d.impulse *= step.GetDeltaTimeRatio()
+	} else {
+		d.impulse = 0
+	}
+	P := d.n.Product(d.impulse)
+	d.applyImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+}
+
+func (d *DistanceJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	r1 := d.getR1(d.localAnchor1)
+	r2 := d.getR2(d.localAnchor2)
+	v1 := r1.CrossZ(d.body1.angularVelocity).AddVector2(d.body1.velocity)
+	v2 := r2.CrossZ(d.body2.angularVelocity).AddVector2(d.body2.velocity)
+	Cdot := d.n.DotVector2(v1.HereToVector2(v2))
+
+	impulse := -d.invK * (Cdot + d.bias + d.gamma*d.impulse)
+	d.impulse += impulse
+	P := d.n.Product(impulse)
+	d.applyImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+}
+
+func (d *DistanceJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	if d.frequency > 0 {
+		return true
+	}
+	linearTolerance := settings.GetLinearTolerance()
+	maxLinearCorrection := settings.GetMaximumLinearCorrection()
+
+	r1 := d.getR1(d.localAnchor1)
+	r2 := d.getR2(d.localAnchor2)
+	n := d.body1.GetWorldCenter().AddVector2(r1).HereToVector2(d.body2.GetWorldCenter().AddVector2(r2))
+	length := n.Normalize()
+	C := geometry.IntervalClamp(length-d.distance, -maxLinearCorrection, maxLinearCorrection)
+
+	impulse := -d.invK * C
+	P := n.Product(impulse)
+	d.applyPositionImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+
+	return math.Abs(C) < linearTolerance
+}
+
+func (d *DistanceJoint) GetAnchor1() *geometry.Vector2 {
+	return d.body1.GetWorldPoint(d.localAnchor1)
+}
+
+func (d *DistanceJoint) GetAnchor2() *geometry.Vector2 {
+	return d.body2.GetWorldPoint(d.localAnchor2)
+}
+
+func (d *DistanceJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return d.n.Product(d.impulse * invdt)
+}
+
+func (d *DistanceJoint) GetReactionTorque(invdt float64) float64 {
+	return 0
+}
+
+func (d *DistanceJoint) GetCurrentDistance() float64 {
+	return d.GetAnchor1().DistanceFromVector2(d.GetAnchor2())
+}
+
+func (d *DistanceJoint) GetDistance() float64 {
+	return d.distance
+}
+
+func (d *DistanceJoint) SetDistance(distance float64) {
+	if distance < 0 {
+		panic("Distance must not be negative")
+	}
+	d.distance = distance
+	d.wakeBodies()
+}
+
+func (d *DistanceJoint) IsSpringEnabled() bool {
+	return d.frequency > 0
+}
+
+func (d *DistanceJoint) GetFrequency() float64 {
+	return d.frequency
+}
+
+func (d *DistanceJoint) SetFrequency(frequency float64) {
+	if frequency < 0 {
+		panic("Frequency must not be negative")
+	}
+	d.frequency = frequency
+	d.wakeBodies()
+}
+
+func (d *DistanceJoint) GetDampingRatio() float64 {
+	return d.dampingRatio
+}
+
+func (d *DistanceJoint) SetDampingRatio(dampingRatio float64) {
+	if dampingRatio < 0 || dampingRatio > 1 {
+		panic("Damping ratio must be between 0 and 1 inclusive")
+	}
+	d.dampingRatio = dampingRatio
+	d.wakeBodies()
+}
@@ -0,0 +1,102 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests creating a distance joint between the same body.
+ */
+func TestDistanceJointSameBody(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	b := NewBody()
+	NewDistanceJoint(b, b, new(geometry.Vector2), new(geometry.Vector2))
+}
+
+/**
+ * Tests setting a negative distance.
+ */
+func TestDistanceJointNegativeDistance(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	_, ground, box := createJointTestWorld()
+	j := NewDistanceJoint(ground, box, ground.GetWorldCenter(), box.GetWorldCenter())
+	j.SetDistance(-1.0)
+}
+
+/**
+ * Tests that a rigid distance joint holds the distance.
+ */
+func TestDistanceJointRigid(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewDistanceJoint(ground, box, ground.GetWorldCenter(), box.GetWorldCenter())
+	dyn4go.AssertEqualWithinError(t, 2.0, j.GetDistance(), 1.0e-9)
+	dyn4go.AssertFalse(t, j.IsSpringEnabled())
+	w.AddJoint(j)
+
+	for i := 0; i < 120; i++ {
+		w.Step(DEFAULT_STEP_FREQUENCY)
+		dyn4go.AssertEqualWithinError(t, 2.0, j.GetCurrentDistance(), 0.01)
+	}
+	dyn4go.AssertTrue(t, box.GetWorldCenter().Y < 0)
+}
+
+/**
+ * Tests that a soft distance joint stretches like a spring.
+ */
+func TestDistanceJointSpring(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	box.TranslateXY(-2.0, -2.0)
+	j := NewDistanceJoint(ground, box, ground.GetWorldCenter(), box.GetWorldCenter())
+	j.SetFrequency(1.0)
+	j.SetDampingRatio(0.1)
+	dyn4go.AssertTrue(t, j.IsSpringEnabled())
+	w.AddJoint(j)
+
+	max := 0.0
+	for i := 0; i < 120; i++ {
+		w.Step(DEFAULT_STEP_FREQUENCY)
+		if d := j.GetCurrentDistance(); d > max {
+			max = d
+		}
+	}
+	dyn4go.AssertTrue(t, max > 2.1)
+}
+
+/**
+ * Tests the reaction force of a hanging body.
+ */
+func TestDistanceJointReactionForce(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	box.TranslateXY(-2.0, -2.0)
+	j := NewDistanceJoint(ground, box, ground.GetWorldCenter(), box.GetWorldCenter())
+	w.AddJoint(j)
+
+	w.StepN(60)
+	f := j.GetReactionForce(w.GetStep().GetInverseDeltaTime())
+	weight := box.GetMass().GetMass() * 9.8
+	dyn4go.AssertEqualWithinError(t, weight, f.GetMagnitude(), weight*0.01)
+	dyn4go.AssertEqual(t, 0.0, j.GetReactionTorque(w.GetStep().GetInverseDeltaTime()))
+}
+
+/**
+ * Tests that accumulated impulses are not applied when warm starting is disabled.
+ */
+func TestDistanceJointWarmStartDisabled(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewDistanceJoint(ground, box, ground.GetWorldCenter(), box.GetWorldCenter())
+	w.AddJoint(j)
+	w.StepN(30)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).GetMagnitude() > 0)
+
+	settings := w.GetSettings()
+	settings.SetWarmStartingEnabled(false)
+	v := geometry.NewVector2FromVector2(box.GetVelocity())
+	av := box.GetAngularVelocity()
+	j.InitializeConstraints(w.GetStep(), settings)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).IsZero())
+	dyn4go.AssertTrue(t, v.EqualsVector2(box.GetVelocity()))
+	dyn4go.AssertEqual(t, av, box.GetAngularVelocity())
+}
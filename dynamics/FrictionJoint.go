@@ -0,0 +1,136 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type FrictionJoint struct {
+	Joint
+	localAnchor1, localAnchor2 *geometry.Vector2
+	maximumForce               float64
+	maximumTorque              float64
+	K                          *geometry.Matrix22
+	angularMass                float64
+	linearImpulse              *geometry.Vector2
+	angularImpulse             float64
+}
+
+var _ Jointer = new(FrictionJoint)
+
+func NewFrictionJoint(body1, body2 *Body, anchor *geometry.Vector2) *FrictionJoint {
+	if body1 == body2 {
+		panic("Cannot create a friction joint between the same body")
+	}
+	if anchor == nil {
+		panic("Cannot create a friction joint with a nil anchor")
+	}
+	f := new(FrictionJoint)
+	InitJoint(&f.Joint, body1, body2, false)
+	f.localAnchor1 = body1.GetLocalPoint(anchor)
+	f.localAnchor2 = body2.GetLocalPoint(anchor)
+	f.linearImpulse = new(geometry.Vector2)
+	return f
+}
+
+func (f *FrictionJoint) InitializeConstraints(step *Step, settings *Settings) {
+	invM1 := f.body1.mass.GetInverseMass()
+	invM2 := f.body2.mass.GetInverseMass()
+	invI1 := f.body1.mass.GetInverseInertia()
+	invI2 := f.body2.mass.GetInverseInertia()
+
+	r1 := f.getR1(f.localAnchor1)
+	r2 := f.getR2(f.localAnchor2)
+
+	k11 := invM1 + invM2 + invI1*r1.Y*r1.Y + invI2*r2.Y*r2.Y
+	k12 := -invI1*r1.X*r1.Y - invI2*r2.X*r2.Y
+	k22 := invM1 + invM2 + invI1*r1.X*r1.X + invI2*r2.X*r2.X
+	f.K = geometry.NewMatrix22FromFloats(k11, k12, k12, k22)
+
+	f.angularMass = invI1 + invI2
+	if f.angularMass > 0 {
+		f.angularMass = 1 / f.angularMass
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		f.linearImpulse.Multiply(step.GetDeltaTimeRatio())
+		f.angularImpulse *= step.GetDeltaTimeRatio()
+	} else {
+		f.linearImpulse.Zero()
+		f.angularImpulse = 0
+	}
+
+	P := f.linearImpulse
+	f.applyImpulse(P, r1.CrossVector2(P)+f.angularImpulse, r2.CrossVector2(P)+f.angularImpulse)
+}
+
+func (f *FrictionJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	dt := step.GetDeltaTime()
+
+	Cdot := f.body2.angularVelocity - f.body1.angularVelocity
+	impulse := -f.angularMass * Cdot
+	oldImpulse := f.angularImpulse
+	maxImpulse := f.maximumTorque * dt
+	f.angularImpulse = geometry.IntervalClamp(f.angularImpulse+impulse, -maxImpulse, maxImpulse)
+	impulse = f.angularImpulse - oldImpulse
+	f.body1.addAngularVelocity(-f.body1.mass.GetInverseInertia() * impulse)
+	f.body2.addAngularVelocity(f.body2.mass.GetInverseInertia() * impulse)
+
+	r1 := f.getR1(f.localAnchor1)
+	r2 := f.getR2(f.localAnchor2)
+	v1 := r1.CrossZ(f.body1.angularVelocity).AddVector2(f.body1.velocity)
+	v2 := r2.CrossZ(f.body2.angularVelocity).AddVector2(f.body2.velocity)
+	P := f.K.Solve(v1.HereToVector2(v2)).Negate()
+	oldLinearImpulse := geometry.NewVector2FromVector2(f.linearImpulse)
+	f.linearImpulse.AddVector2(P)
+	maxImpulse = f.maximumForce * dt
+	if f.linearImpulse.GetMagnitudeSquared() > maxImpulse*maxImpulse {
+		f.linearImpulse.Normalize()
+		f.linearImpulse.Multiply(maxImpulse)
+	}
+	P = oldLinearImpulse.HereToVector2(f.linearImpulse)
+	f.applyImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+}
+
+func (f *FrictionJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	return true
+}
+
+func (f *FrictionJoint) GetAnchor1() *geometry.Vector2 {
+	return f.body1.GetWorldPoint(f.localAnchor1)
+}
+
+func (f *FrictionJoint) GetAnchor2() *geometry.Vector2 {
+	return f.body2.GetWorldPoint(f.localAnchor2)
+}
+
+func (f *FrictionJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return f.linearImpulse.Product(invdt)
+}
+
+func (f *FrictionJoint) GetReactionTorque(invdt float64) float64 {
+	return f.angularImpulse * invdt
+}
+
+func (f *FrictionJoint) GetMaximumForce() float64 {
+	return f.maximumForce
+}
+
+func (f *FrictionJoint) SetMaximumForce(maximumForce float64) {
+	if maximumForce < 0 {
+		panic("Maximum force must not be negative")
+	}
+	f.maximumForce = maximumForce
+	f.wakeBodies()
+}
+
+func (f *FrictionJoint) GetMaximumTorque() float64 {
+	return f.maximumTorque
+}
+
+func (f *FrictionJoint) SetMaximumTorque(maximumTorque float64) {
+	if maximumTorque < 0 {
+		panic("Maximum torque must not be negative")
+	}
+	f.maximumTorque = maximumTorque
+	f.wakeBodies()
+}
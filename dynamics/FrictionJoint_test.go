@@ -0,0 +1,44 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests setting a negative maximum force.
+ */
+func TestFrictionJointNegativeForce(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	_, ground, box := createJointTestWorld()
+	j := NewFrictionJoint(ground, box, box.GetWorldCenter())
+	j.SetMaximumForce(-1.0)
+}
+
+/**
+ * Tests that the friction joint slows a moving body.
+ */
+func TestFrictionJointSlowdown(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	box.SetLinearDamping(0.0)
+	box.SetAngularDamping(0.0)
+	box.SetVelocity(geometry.NewVector2FromXY(1.0, 0.0))
+	box.SetAngularVelocity(1.0)
+	j := NewFrictionJoint(ground, box, box.GetWorldCenter())
+	j.SetMaximumForce(box.GetMass().GetMass())
+	j.SetMaximumTorque(box.GetMass().GetInertia())
+	w.AddJoint(j)
+
+	w.StepN(30)
+	dyn4go.AssertEqualWithinError(t, 0.5, box.GetVelocity().X, 0.01)
+	dyn4go.AssertEqualWithinError(t, 0.5, box.GetAngularVelocity(), 0.01)
+	invdt := w.GetStep().GetInverseDeltaTime()
+	dyn4go.AssertEqualWithinError(t, box.GetMass().GetMass(), j.GetReactionForce(invdt).GetMagnitude(), 1.0e-9)
+
+	w.StepN(60)
+	dyn4go.AssertEqualWithinError(t, 0.0, box.GetVelocity().X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 0.0, box.GetAngularVelocity(), 1.0e-9)
+}
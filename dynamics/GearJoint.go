@@ -0,0 +1,200 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type GearJoint struct {
+	Joint
+	joint1, joint2             Jointer
+	body3, body4               *Body
+	localAnchor1, localAnchor2 *geometry.Vector2
+	localAnchor3, localAnchor4 *geometry.Vector2
+	localAxis3, localAxis4     *geometry.Vector2
+	ratio                      float64
+	constant                   float64
+	angle1, angle2             float64
+	invK                       float64
+	impulse                    float64
+	J13, J24                   *geometry.Vector2
+	Jw1, Jw2, Jw3, Jw4         float64
+}
+
+var _ Jointer = new(GearJoint)
+
+// NewGearJoint couples the second bodies of joint1 and joint2. The gear joint
+// also moves the joints' first bodies but only the joints themselves link those
+// into the same island, so both joints must be added to the world before it.
+func NewGearJoint(joint1, joint2 Jointer, ratio float64) *GearJoint {
+	if joint1 == nil || joint2 == nil {
+		panic("Cannot create a gear joint with a nil joint")
+	}
+	if joint1 == joint2 {
+		panic("Cannot create a gear joint between the same joint")
+	}
+	if ratio == 0 {
+		panic("Ratio must not be zero")
+	}
+	g := new(GearJoint)
+	InitJoint(&g.Joint, joint1.GetBody2(), joint2.GetBody2(), false)
+	g.joint1 = joint1
+	g.joint2 = joint2
+	g.body3 = joint1.GetBody1()
+	g.body4 = joint2.GetBody1()
+	g.localAnchor3, g.localAnchor1, g.localAxis3 = getGearJointParameters(joint1)
+	g.localAnchor4, g.localAnchor2, g.localAxis4 = getGearJointParameters(joint2)
+	g.ratio = ratio
+	g.constant = g.getCoordinate1() + g.ratio*g.getCoordinate2()
+	g.J13 = new(geometry.Vector2)
+	g.J24 = new(geometry.Vector2)
+	return g
+}
+
+func getGearJointParameters(joint Jointer) (*geometry.Vector2, *geometry.Vector2, *geometry.Vector2) {
+	switch j := joint.(type) {
+	case *RevoluteJoint:
+		return j.localAnchor1, j.localAnchor2, nil
+	case *PrismaticJoint:
+		return j.localAnchor1, j.localAnchor2, j.xAxis
+	}
+	panic("Gear joints can only connect revolute and prismatic joints")
+}
+
+func getGearJointCoordinate(b1, b2 *Body, localAnchor1, localAnchor2, localAxis1 *geometry.Vector2, angle *float64) float64 {
+	if localAxis1 == nil {
+		*angle += math.Remainder(b2.transform.GetRotation()-b1.transform.GetRotation()-*angle, geometry.TWO_PI)
+		return *angle
+	}
+	d := b1.GetLocalPoint(b2.GetWorldPoint(localAnchor2)).SubtractVector2(localAnchor1)
+	return d.DotVector2(localAxis1)
+}
+
+func (g *GearJoint) getCoordinate1() float64 {
+	return getGearJointCoordinate(g.body3, g.body1, g.localAnchor3, g.localAnchor1, g.localAxis3, &g.angle1)
+}
+
+func (g *GearJoint) getCoordinate2() float64 {
+	return getGearJointCoordinate(g.body4, g.body2, g.localAnchor4, g.localAnchor2, g.localAxis4, &g.angle2)
+}
+
+func (g *GearJoint) computeJacobians() float64 {
+	invK := 0.0
+	if g.localAxis3 == nil {
+		g.J13.Zero()
+		g.Jw1 = 1
+		g.Jw3 = 1
+		invK += g.body1.mass.GetInverseInertia() + g.body3.mass.GetInverseInertia()
+	} else {
+		u := g.body3.GetWorldVector(g.localAxis3)
+		r3 := g.body3.transform.GetTransformedR(g.body3.GetLocalCenter().HereToVector2(g.localAnchor3))
+		r1 := g.getR1(g.localAnchor1)
+		g.J13 = u
+		g.Jw3 = r3.CrossVector2(u)
+		g.Jw1 = r1.CrossVector2(u)
+		invK += g.body3.mass.GetInverseMass() + g.body1.mass.GetInverseMass() +
+			g.body3.mass.GetInverseInertia()*g.Jw3*g.Jw3 + g.body1.mass.GetInverseInertia()*g.Jw1*g.Jw1
+	}
+	if g.localAxis4 == nil {
+		g.J24.Zero()
+		g.Jw2 = g.ratio
+		g.Jw4 = g.ratio
+		invK += g.ratio * g.ratio * (g.body2.mass.GetInverseInertia() + g.body4.mass.GetInverseInertia())
+	} else {
+		u := g.body4.GetWorldVector(g.localAxis4)
+		r4 := g.body4.transform.GetTransformedR(g.body4.GetLocalCenter().HereToVector2(g.localAnchor4))
+		r2 := g.getR2(g.localAnchor2)
+		g.J24 = u.Product(g.ratio)
+		g.Jw4 = g.ratio * r4.CrossVector2(u)
+		g.Jw2 = g.ratio * r2.CrossVector2(u)
+		invK += g.ratio*g.ratio*(g.body4.mass.GetInverseMass()+g.body2.mass.GetInverseMass()) +
+			g.body4.mass.GetInverseInertia()*g.Jw4*g.Jw4 + g.body2.mass.GetInverseInertia()*g.Jw2*g.Jw2
+	}
+	return invK
+}
+
+func (g *GearJoint) InitializeConstraints(step *Step, settings *Settings) {
+	g.invK = g.computeJacobians()
+	if g.invK > 0 {
+		g.invK = 1 / g.invK
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		g.impulse *= step.GetDeltaTimeRatio()
+	} else {
+		g.impulse = 0
+	}
+	g.applyGearImpulse(g.impulse)
+}
+
+func (g *GearJoint) applyGearImpulse(impulse float64) {
+	g.body1.addVelocity(g.J13.Product(g.body1.mass.GetInverseMass()*impulse), g.body1.mass.GetInverseInertia()*impulse*g.Jw1)
+	g.body2.addVelocity(g.J24.Product(g.body2.mass.GetInverseMass()*impulse), g.body2.mass.GetInverseInertia()*impulse*g.Jw2)
+	g.body3.subtractVelocity(g.J13.Product(g.body3.mass.GetInverseMass()*impulse), g.body3.mass.GetInverseInertia()*impulse*g.Jw3)
+	g.body4.subtractVelocity(g.J24.Product(g.body4.mass.GetInverseMass()*impulse), g.body4.mass.GetInverseInertia()*impulse*g.Jw4)
+}
+
+func (g *GearJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	Cdot := g.J13.DotVector2(g.body3.velocity.HereToVector2(g.body1.velocity)) +
+		g.J24.DotVector2(g.body4.velocity.HereToVector2(g.body2.velocity)) +
+		g.Jw1*g.body1.angularVelocity - g.Jw3*g.body3.angularVelocity +
+		g.Jw2*g.body2.angularVelocity - g.Jw4*g.body4.angularVelocity
+
+	impulse := -g.invK * Cdot
+	g.impulse += impulse
+	g.applyGearImpulse(impulse)
+}
+
+func (g *GearJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	invK := g.computeJacobians()
+	C := g.getCoordinate1() + g.ratio*g.getCoordinate2() - g.constant
+	impulse := 0.0
+	if invK > 0 {
+		impulse = -C / invK
+	}
+
+	g.body1.correctPosition(g.J13.Product(g.body1.mass.GetInverseMass()*impulse), g.body1.mass.GetInverseInertia()*impulse*g.Jw1)
+	g.body2.correctPosition(g.J24.Product(g.body2.mass.GetInverseMass()*impulse), g.body2.mass.GetInverseInertia()*impulse*g.Jw2)
+	g.body3.correctPosition(g.J13.Product(-g.body3.mass.GetInverseMass()*impulse), -g.body3.mass.GetInverseInertia()*impulse*g.Jw3)
+	g.body4.correctPosition(g.J24.Product(-g.body4.mass.GetInverseMass()*impulse), -g.body4.mass.GetInverseInertia()*impulse*g.Jw4)
+
+	return math.Abs(C) < settings.GetLinearTolerance()
+}
+
+func (g *GearJoint) GetAnchor1() *geometry.Vector2 {
+	return g.body1.GetWorldPoint(g.localAnchor1)
+}
+
+func (g *GearJoint) GetAnchor2() *geometry.Vector2 {
+	return g.body2.GetWorldPoint(g.localAnchor2)
+}
+
+func (g *GearJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return g.J24.Product(g.impulse * invdt)
+}
+
+func (g *GearJoint) GetReactionTorque(invdt float64) float64 {
+	return g.impulse * g.Jw2 * invdt
+}
+
+func (g *GearJoint) GetJoint1() Jointer {
+	return g.joint1
+}
+
+func (g *GearJoint) GetJoint2() Jointer {
+	return g.joint2
+}
+
+func (g *GearJoint) GetRatio() float64 {
+	return g.ratio
+}
+
+func (g *GearJoint) SetRatio(ratio float64) {
+	if ratio == 0 {
+		panic("Ratio must not be zero")
+	}
+	g.ratio = ratio
+	g.constant = g.getCoordinate1() + g.ratio*g.getCoordinate2()
+	g.wakeBodies()
+}
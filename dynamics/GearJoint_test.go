@@ -0,0 +1,118 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests creating a gear joint with an unsupported joint.
+ */
+func TestGearJointUnsupportedJoint(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	ground := createWorldTestBody(geometry.CreateCircle(0.1), geometry.INFINITE)
+	ground.TranslateXY(0.0, -5.0)
+	b1 := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateCircle(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	j1 := NewRevoluteJoint(ground, b1, b1.GetWorldCenter())
+	j2 := NewDistanceJoint(ground, b2, ground.GetWorldCenter(), b2.GetWorldCenter())
+	NewGearJoint(j1, j2, 1.0)
+}
+
+/**
+ * Tests coupling two revolute joints over several revolutions.
+ */
+func TestGearJointRevolute(t *testing.T) {
+	ground := createWorldTestBody(geometry.CreateCircle(0.1), geometry.INFINITE)
+	ground.TranslateXY(0.0, -5.0)
+	b1 := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateCircle(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	for _, b := range []*Body{b1, b2} {
+		b.SetLinearDamping(0.0)
+		b.SetAngularDamping(0.0)
+	}
+	w := NewWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	w.AddBody(ground)
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j1 := NewRevoluteJoint(ground, b1, b1.GetWorldCenter())
+	j2 := NewRevoluteJoint(ground, b2, b2.GetWorldCenter())
+	g := NewGearJoint(j1, j2, 2.0)
+	w.AddJoint(j1)
+	w.AddJoint(j2)
+	w.AddJoint(g)
+	dyn4go.AssertTrue(t, g.GetJoint1() == j1)
+	b1.SetAngularVelocity(4.0)
+
+	w.StepN(300)
+	dyn4go.AssertEqualWithinError(t, b1.GetAngularVelocity(), -2.0*b2.GetAngularVelocity(), 1.0e-6)
+	dyn4go.AssertTrue(t, b1.GetAngularVelocity() > 0)
+	dyn4go.AssertEqualWithinError(t, 0.0, g.getCoordinate1()+2.0*g.getCoordinate2(), 0.01)
+}
+
+/**
+ * Tests coupling a revolute and a prismatic joint like a rack and pinion.
+ */
+func TestGearJointRackAndPinion(t *testing.T) {
+	ground := createWorldTestBody(geometry.CreateCircle(0.1), geometry.INFINITE)
+	ground.TranslateXY(0.0, -5.0)
+	b1 := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateCircle(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	for _, b := range []*Body{b1, b2} {
+		b.SetLinearDamping(0.0)
+		b.SetAngularDamping(0.0)
+	}
+	w := NewWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	w.AddBody(ground)
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j1 := NewRevoluteJoint(ground, b1, b1.GetWorldCenter())
+	j2 := NewPrismaticJoint(ground, b2, b2.GetWorldCenter(), geometry.NewVector2FromXY(0.0, 1.0))
+	g := NewGearJoint(j1, j2, 1.0)
+	w.AddJoint(j1)
+	w.AddJoint(j2)
+	w.AddJoint(g)
+	b1.SetAngularVelocity(1.0)
+
+	w.StepN(60)
+	dyn4go.AssertEqualWithinError(t, -b1.GetAngularVelocity(), b2.GetVelocity().Y, 1.0e-6)
+	dyn4go.AssertEqualWithinError(t, 2.0, b2.GetWorldCenter().X, 0.01)
+	dyn4go.AssertEqualWithinError(t, -j2.GetJointTranslation(), g.getCoordinate1(), 0.01)
+}
+
+/**
+ * Tests adding a gear joint before the joints it couples.
+ */
+func TestGearJointMissingJoints(t *testing.T) {
+	ground := createWorldTestBody(geometry.CreateCircle(0.1), geometry.INFINITE)
+	b1 := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateCircle(0.5), geometry.NORMAL)
+	b2.TranslateXY(2.0, 0.0)
+	w := NewWorld()
+	w.AddBody(ground)
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j1 := NewRevoluteJoint(ground, b1, b1.GetWorldCenter())
+	j2 := NewRevoluteJoint(ground, b2, b2.GetWorldCenter())
+	g := NewGearJoint(j1, j2, 2.0)
+	func() {
+		defer dyn4go.AssertPanic(t)
+		w.AddJoint(g)
+	}()
+	w.AddJoint(j1)
+	func() {
+		defer dyn4go.AssertPanic(t)
+		w.AddJoint(g)
+	}()
+	dyn4go.AssertFalse(t, w.ContainsJoint(g))
+	w.AddJoint(j2)
+	w.AddJoint(g)
+	dyn4go.AssertTrue(t, w.ContainsJoint(g))
+}
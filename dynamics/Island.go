@@ -0,0 +1,107 @@
+package dynamics
+
+import (
+	"math"
+)
+
+type Island struct {
+	bodies             []*Body
+	joints             []Jointer
+	contactConstraints []*ContactConstraint
+}
+
+func NewIsland() *Island {
+	return NewIslandInt(16)
+}
+
+func NewIslandInt(initialCapacity int) *Island {
+	i := new(Island)
+	i.bodies = make([]*Body, 0, initialCapacity)
+	i.joints = make([]Jointer, 0, initialCapacity/2)
+	i.contactConstraints = make([]*ContactConstraint, 0, initialCapacity)
+	return i
+}
+
+func (i *Island) addBody(body *Body) {
+	i.bodies = append(i.bodies, body)
+}
+
+func (i *Island) addJoint(joint Jointer) {
+	i.joints = append(i.joints, joint)
+}
+
+func (i *Island) addContactConstraint(contactConstraint *ContactConstraint) {
+	i.contactConstraints = append(i.contactConstraints, contactConstraint)
+}
+
+func (i *Island) GetBodies() []*Body {
+	return i.bodies
+}
+
+func (i *Island) GetJoints() []Jointer {
+	return i.joints
+}
+
+func (i *Island) GetContactConstraints() []*ContactConstraint {
+	return i.contactConstraints
+}
+
+func (i *Island) solve(contactSolver ContactConstraintSolver, step *Step, settings *Settings) {
+	contactSolver.Initialize(i.contactConstraints, step, settings)
+	for _, joint := range i.joints {
+		joint.InitializeConstraints(step, settings)
+	}
+	for k := 0; k < settings.GetVelocityConstraintSolverIterations(); k++ {
+		for _, joint := range i.joints {
+			joint.SolveVelocityConstraints(step, settings)
+		}
+		contactSolver.SolveVelocityConstraints(i.contactConstraints, step, settings)
+	}
+	for _, body := range i.bodies {
+		if body.IsDynamic() {
+			body.integratePosition(step, settings)
+		}
+	}
+	solved := false
+	for k := 0; k < settings.GetPositionConstraintSolverIterations(); k++ {
+		contactsSolved := contactSolver.SolvePositionConstraints(i.contactConstraints, step, settings)
+		jointsSolved := true
+		for _, joint := range i.joints {
+			jointsSolved = joint.SolvePositionConstraints(step, settings) && jointsSolved
+		}
+		if contactsSolved && jointsSolved {
+			solved = true
+			break
+		}
+	}
+	if settings.IsAutoSleepingEnabled() {
+		i.updateSleep(solved, step, settings)
+	}
+}
+
+func (i *Island) updateSleep(solved bool, step *Step, settings *Settings) {
+	linearTolerance := settings.GetSleepLinearVelocitySquared()
+	angularTolerance := settings.GetSleepAngularVelocitySquared()
+	minSleepTime := math.MaxFloat64
+	for _, body := range i.bodies {
+		if !body.IsDynamic() {
+			continue
+		}
+		if !body.autoSleep ||
+			body.velocity.GetMagnitudeSquared() > linearTolerance ||
+			body.angularVelocity*body.angularVelocity > angularTolerance {
+			body.sleepTime = 0
+			minSleepTime = 0
+		} else {
+			body.sleepTime += step.dt
+			minSleepTime = math.Min(minSleepTime, body.sleepTime)
+		}
+	}
+	if solved && minSleepTime >= settings.GetSleepTime() {
+		for _, body := range i.bodies {
+			if body.IsDynamic() {
+				body.SetAsleep(true)
+			}
+		}
+	}
+}
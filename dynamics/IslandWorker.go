@@ -0,0 +1,33 @@
+package dynamics
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type islandWorker struct {
+	contactSolver ContactConstraintSolver
+}
+
+func newIslandWorker(contactSolver ContactConstraintSolverCloner) *islandWorker {
+	return &islandWorker{contactSolver: contactSolver.Clone()}
+}
+
+func solveIslands(islands []*Island, workers []*islandWorker, step *Step, settings *Settings) {
+	var next int64 = -1
+	var wg sync.WaitGroup
+	wg.Add(len(workers))
+	for _, worker := range workers {
+		go func(worker *islandWorker) {
+			defer wg.Done()
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= len(islands) {
+					return
+				}
+				islands[i].solve(worker.contactSolver, step, settings)
+			}
+		}(worker)
+	}
+	wg.Wait()
+}
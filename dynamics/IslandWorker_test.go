@@ -0,0 +1,150 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests that solving islands on several workers gives exactly the serial result.
+ */
+func TestIslandWorkerParallelIdentical(t *testing.T) {
+	worlds := make([]*World, 2)
+	for k := range worlds {
+		w := NewWorld()
+		floor := createWorldTestBody(geometry.CreateRectangle(100.0, 1.0), geometry.INFINITE)
+		w.AddBody(floor)
+		for i := 0; i < 16; i++ {
+			x := -40.0 + float64(i)*5.0
+			for j := 0; j < 3; j++ {
+				box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+				box.TranslateXY(x+0.1*float64(j), 1.0+1.1*float64(j))
+				box.RotateAboutCenter(0.05 * float64(i-j))
+				w.AddBody(box)
+			}
+			pendulum := createWorldTestBody(geometry.CreateCircle(0.25), geometry.NORMAL)
+			pendulum.TranslateXY(x+1.5, 8.0)
+			w.AddBody(pendulum)
+			w.AddJoint(NewRevoluteJoint(floor, pendulum, geometry.NewVector2FromXY(x, 8.0)))
+		}
+		worlds[k] = w
+	}
+	serial, parallel := worlds[0], worlds[1]
+	parallel.GetSettings().SetIslandSolverWorkerCount(4)
+
+	for i := 0; i < 180; i++ {
+		serial.Step(DEFAULT_STEP_FREQUENCY)
+		parallel.Step(DEFAULT_STEP_FREQUENCY)
+		dyn4go.AssertTrue(t, parallel.constraintGraph.GetIslandCount() > 1)
+		for k, b1 := range serial.bodies {
+			b2 := parallel.bodies[k]
+			dyn4go.AssertEqual(t, b1.GetTransform().X, b2.GetTransform().X)
+			dyn4go.AssertEqual(t, b1.GetTransform().Y, b2.GetTransform().Y)
+			dyn4go.AssertEqual(t, b1.GetTransform().GetRotation(), b2.GetTransform().GetRotation())
+			dyn4go.AssertEqual(t, b1.GetVelocity().X, b2.GetVelocity().X)
+			dyn4go.AssertEqual(t, b1.GetVelocity().Y, b2.GetVelocity().Y)
+			dyn4go.AssertEqual(t, b1.GetAngularVelocity(), b2.GetAngularVelocity())
+			dyn4go.AssertEqual(t, b1.IsAsleep(), b2.IsAsleep())
+		}
+	}
+}
+
+/**
+ * Tests setting an invalid island solver worker count.
+ */
+func TestIslandWorkerCountInvalid(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	NewSettings().SetIslandSolverWorkerCount(0)
+}
+
+type islandWorkerTestSolver struct {
+	solver      *SequentialImpulses
+	initialized int
+	clones      *[]*islandWorkerTestSolver
+}
+
+func (s *islandWorkerTestSolver) Initialize(contactConstraints []*ContactConstraint, step *Step, settings *Settings) {
+	s.initialized++
+	s.solver.Initialize(contactConstraints, step, settings)
+}
+
+func (s *islandWorkerTestSolver) SolveVelocityConstraints(contactConstraints []*ContactConstraint, step *Step, settings *Settings) {
+	s.solver.SolveVelocityConstraints(contactConstraints, step, settings)
+}
+
+func (s *islandWorkerTestSolver) SolvePositionConstraints(contactConstraints []*ContactConstraint, step *Step, settings *Settings) bool {
+	return s.solver.SolvePositionConstraints(contactConstraints, step, settings)
+}
+
+type islandWorkerTestClonedSolver struct {
+	islandWorkerTestSolver
+}
+
+func (s *islandWorkerTestClonedSolver) Clone() ContactConstraintSolver {
+	clone := new(islandWorkerTestClonedSolver)
+	clone.solver = new(SequentialImpulses)
+	clone.clones = s.clones
+	*s.clones = append(*s.clones, &clone.islandWorkerTestSolver)
+	return clone
+}
+
+/**
+ * Tests that stateful custom contact solvers are never shared between workers.
+ * Run with -race to detect shared scratch state.
+ */
+func TestIslandWorkerStatefulSolver(t *testing.T) {
+	worlds := make([]*World, 3)
+	for k := range worlds {
+		w := NewWorld()
+		floor := createWorldTestBody(geometry.CreateRectangle(100.0, 1.0), geometry.INFINITE)
+		w.AddBody(floor)
+		for i := 0; i < 16; i++ {
+			x := -40.0 + float64(i)*5.0
+			for j := 0; j < 3; j++ {
+				box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+				box.TranslateXY(x+0.1*float64(j), 1.0+1.1*float64(j))
+				box.RotateAboutCenter(0.05 * float64(i-j))
+				w.AddBody(box)
+			}
+			pendulum := createWorldTestBody(geometry.CreateCircle(0.25), geometry.NORMAL)
+			pendulum.TranslateXY(x+1.5, 8.0)
+			w.AddBody(pendulum)
+			w.AddJoint(NewRevoluteJoint(floor, pendulum, geometry.NewVector2FromXY(x, 8.0)))
+		}
+		worlds[k] = w
+	}
+	serial, shared, cloned := worlds[0], worlds[1], worlds[2]
+	sharedSolver := &islandWorkerTestSolver{solver: new(SequentialImpulses)}
+	shared.SetContactConstraintSolver(sharedSolver)
+	shared.GetSettings().SetIslandSolverWorkerCount(4)
+	clones := make([]*islandWorkerTestSolver, 0)
+	clonedSolver := new(islandWorkerTestClonedSolver)
+	clonedSolver.solver = new(SequentialImpulses)
+	clonedSolver.clones = &clones
+	cloned.SetContactConstraintSolver(clonedSolver)
+	cloned.GetSettings().SetIslandSolverWorkerCount(4)
+
+	islands := 0
+	for i := 0; i < 60; i++ {
+		serial.Step(DEFAULT_STEP_FREQUENCY)
+		shared.Step(DEFAULT_STEP_FREQUENCY)
+		cloned.Step(DEFAULT_STEP_FREQUENCY)
+		islands += serial.constraintGraph.GetIslandCount()
+		for k, b1 := range serial.bodies {
+			dyn4go.AssertEqual(t, b1.GetTransform().X, shared.bodies[k].GetTransform().X)
+			dyn4go.AssertEqual(t, b1.GetTransform().Y, shared.bodies[k].GetTransform().Y)
+			dyn4go.AssertEqual(t, b1.GetTransform().X, cloned.bodies[k].GetTransform().X)
+			dyn4go.AssertEqual(t, b1.GetTransform().Y, cloned.bodies[k].GetTransform().Y)
+		}
+	}
+	dyn4go.AssertEqual(t, islands, sharedSolver.initialized)
+	dyn4go.AssertEqual(t, 4, len(clones))
+	dyn4go.AssertEqual(t, 0, clonedSolver.initialized)
+	initialized := 0
+	for _, clone := range clones {
+		initialized += clone.initialized
+	}
+	dyn4go.AssertEqual(t, islands, initialized)
+}
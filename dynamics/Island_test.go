@@ -0,0 +1,146 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests that a resting stack falls asleep together.
+ */
+func TestIslandSleep(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	bottom := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	bottom.TranslateXY(0.0, 1.0)
+	top := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	top.TranslateXY(0.0, 2.0)
+	w.AddBody(bottom)
+	w.AddBody(top)
+
+	w.StepN(10)
+	dyn4go.AssertFalse(t, bottom.IsAsleep())
+	dyn4go.AssertFalse(t, top.IsAsleep())
+
+	w.StepN(120)
+	dyn4go.AssertTrue(t, bottom.IsAsleep())
+	dyn4go.AssertTrue(t, top.IsAsleep())
+	dyn4go.AssertEqual(t, 2, len(w.GetContactConstraints()))
+
+	y := top.GetTransform().Y
+	w.StepN(10)
+	dyn4go.AssertEqual(t, y, top.GetTransform().Y)
+	dyn4go.AssertEqual(t, 2, len(w.GetContactConstraints()))
+}
+
+/**
+ * Tests that bodies stay awake when auto sleeping is disabled.
+ */
+func TestIslandSleepDisabled(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	bottom := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	bottom.TranslateXY(0.0, 1.0)
+	top := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	top.TranslateXY(0.0, 2.0)
+	w.AddBody(bottom)
+	w.AddBody(top)
+	w.GetSettings().SetAutoSleepingEnabled(false)
+
+	w.StepN(130)
+	dyn4go.AssertFalse(t, bottom.IsAsleep())
+	dyn4go.AssertFalse(t, top.IsAsleep())
+
+	w.GetSettings().SetAutoSleepingEnabled(true)
+	top.SetAutoSleepingEnabled(false)
+	w.StepN(130)
+	dyn4go.AssertFalse(t, bottom.IsAsleep())
+	dyn4go.AssertFalse(t, top.IsAsleep())
+}
+
+/**
+ * Tests that waking one body wakes its island.
+ */
+func TestIslandWakeOnForce(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	bottom := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	bottom.TranslateXY(0.0, 1.0)
+	top := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	top.TranslateXY(0.0, 2.0)
+	w.AddBody(bottom)
+	w.AddBody(top)
+	w.StepN(130)
+	dyn4go.AssertTrue(t, bottom.IsAsleep())
+
+	top.ApplyForce(geometry.NewVector2FromXY(0.0, 1.0))
+	dyn4go.AssertFalse(t, top.IsAsleep())
+	dyn4go.AssertTrue(t, bottom.IsAsleep())
+
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertFalse(t, bottom.IsAsleep())
+}
+
+/**
+ * Tests that a new contact wakes a sleeping body.
+ */
+func TestIslandWakeOnContact(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	bottom := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	bottom.TranslateXY(0.0, 1.0)
+	top := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	top.TranslateXY(0.0, 2.0)
+	w.AddBody(bottom)
+	w.AddBody(top)
+	w.RemoveBody(top)
+	w.StepN(130)
+	dyn4go.AssertTrue(t, bottom.IsAsleep())
+
+	ball := createWorldTestBody(geometry.CreateCircle(0.25), geometry.NORMAL)
+	ball.TranslateXY(0.0, 2.0)
+	w.AddBody(ball)
+	for i := 0; i < 60 && bottom.IsAsleep(); i++ {
+		w.Step(DEFAULT_STEP_FREQUENCY)
+	}
+	dyn4go.AssertFalse(t, bottom.IsAsleep())
+	dyn4go.AssertTrue(t, ball.GetTransform().Y < 1.8)
+}
+
+/**
+ * Tests that removing a supporting body wakes the bodies resting on it.
+ */
+func TestIslandWakeOnRemove(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	bottom := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	bottom.TranslateXY(0.0, 1.0)
+	top := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	top.TranslateXY(0.0, 2.0)
+	w.AddBody(bottom)
+	w.AddBody(top)
+	w.StepN(130)
+	dyn4go.AssertTrue(t, top.IsAsleep())
+
+	w.RemoveBody(bottom)
+	dyn4go.AssertFalse(t, top.IsAsleep())
+	w.StepN(10)
+	dyn4go.AssertTrue(t, top.GetTransform().Y < 2.0)
+}
+
+/**
+ * Tests that joint changes wake the jointed bodies.
+ */
+func TestIslandWakeOnJoint(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	box.TranslateXY(-2.0, -2.0)
+	j := NewRevoluteJoint(ground, box, new(geometry.Vector2))
+	w.AddJoint(j)
+	w.StepN(120)
+	dyn4go.AssertTrue(t, box.IsAsleep())
+
+	j.SetMotorEnabled(true)
+	dyn4go.AssertFalse(t, box.IsAsleep())
+
+	w.StepN(120)
+	dyn4go.AssertTrue(t, box.IsAsleep())
+	w.RemoveJoint(j)
+	dyn4go.AssertFalse(t, box.IsAsleep())
+}
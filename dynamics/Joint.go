@@ -0,0 +1,111 @@
+package dynamics
+
+import (
+	"math"
+
+	"code.google.com/p/uuid"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+const (
+	LIMIT_STATE_INACTIVE = iota
+	LIMIT_STATE_AT_LOWER
+	LIMIT_STATE_AT_UPPER
+	LIMIT_STATE_EQUAL
+)
+
+type Jointer interface {
+	GetID() string
+	GetBody1() *Body
+	GetBody2() *Body
+	GetAnchor1() *geometry.Vector2
+	GetAnchor2() *geometry.Vector2
+	GetReactionForce(invdt float64) *geometry.Vector2
+	GetReactionTorque(invdt float64) float64
+	IsCollisionAllowed() bool
+	SetCollisionAllowed(flag bool)
+	GetUserData() interface{}
+	SetUserData(data interface{})
+	InitializeConstraints(step *Step, settings *Settings)
+	SolveVelocityConstraints(step *Step, settings *Settings)
+	SolvePositionConstraints(step *Step, settings *Settings) bool
+}
+
+type Joint struct {
+	id               string
+	body1, body2     *Body
+	collisionAllowed bool
+	userData         interface{}
+}
+
+func InitJoint(j *Joint, body1, body2 *Body, collisionAllowed bool) {
+	if body1 == nil || body2 == nil {
+		panic("Cannot create joint with nil body")
+	}
+	j.id = uuid.New()
+	j.body1 = body1
+	j.body2 = body2
+	j.collisionAllowed = collisionAllowed
+}
+
+func (j *Joint) GetID() string {
+	return j.id
+}
+
+func (j *Joint) GetBody1() *Body {
+	return j.body1
+}
+
+func (j *Joint) GetBody2() *Body {
+	return j.body2
+}
+
+func (j *Joint) IsCollisionAllowed() bool {
+	return j.collisionAllowed
+}
+
+func (j *Joint) SetCollisionAllowed(flag bool) {
+	j.collisionAllowed = flag
+}
+
+func (j *Joint) GetUserData() interface{} {
+	return j.userData
+}
+
+func (j *Joint) SetUserData(data interface{}) {
+	j.userData = data
+}
+
+func (j *Joint) wakeBodies() {
+	j.body1.SetAsleep(false)
+	j.body2.SetAsleep(false)
+}
+
+func (j *Joint) applyImpulse(P *geometry.Vector2, L1, L2 float64) {
+	j.body1.subtractVelocity(P.Product(j.body1.mass.GetInverseMass()), j.body1.mass.GetInverseInertia()*L1)
+	j.body2.addVelocity(P.Product(j.body2.mass.GetInverseMass()), j.body2.mass.GetInverseInertia()*L2)
+}
+
+func (j *Joint) applyPositionImpulse(P *geometry.Vector2, L1, L2 float64) {
+	j.body1.correctPosition(P.Product(-j.body1.mass.GetInverseMass()), -j.body1.mass.GetInverseInertia()*L1)
+	j.body2.correctPosition(P.Product(j.body2.mass.GetInverseMass()), j.body2.mass.GetInverseInertia()*L2)
+}
+
+func (j *Joint) getR1(localAnchor1 *geometry.Vector2) *geometry.Vector2 {
+	return j.body1.transform.GetTransformedR(j.body1.GetLocalCenter().HereToVector2(localAnchor1))
+}
+
+func (j *Joint) getR2(localAnchor2 *geometry.Vector2) *geometry.Vector2 {
+	return j.body2.transform.GetTransformedR(j.body2.GetLocalCenter().HereToVector2(localAnchor2))
+}
+
+func getRelativeRotation(body1, body2 *Body, referenceAngle float64) float64 {
+	rr := body1.transform.GetRotation() - body2.transform.GetRotation() - referenceAngle
+	if rr < -math.Pi {
+		rr += geometry.TWO_PI
+	}
+	if rr > math.Pi {
+		rr -= geometry.TWO_PI
+	}
+	return rr
+}
@@ -0,0 +1,170 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type MotorJoint struct {
+	Joint
+	linearOffset     *geometry.Vector2
+	angularOffset    float64
+	correctionFactor float64
+	maximumForce     float64
+	maximumTorque    float64
+	linearMass       float64
+	angularMass      float64
+	linearError      *geometry.Vector2
+	angularError     float64
+	linearImpulse    *geometry.Vector2
+	angularImpulse   float64
+}
+
+var _ Jointer = new(MotorJoint)
+
+func NewMotorJoint(body1, body2 *Body) *MotorJoint {
+	if body1 == body2 {
+		panic("Cannot create a motor joint between the same body")
+	}
+	m := new(MotorJoint)
+	InitJoint(&m.Joint, body1, body2, false)
+	m.linearOffset = body1.GetLocalVector(body1.GetWorldCenter().HereToVector2(body2.GetWorldCenter()))
+	m.angularOffset = body2.transform.GetRotation() - body1.transform.GetRotation()
+	m.correctionFactor = 0.3
+	m.maximumForce = 1000.0
+	m.maximumTorque = 1000.0
+	m.linearError = new(geometry.Vector2)
+	m.linearImpulse = new(geometry.Vector2)
+	return m
+}
+
+func (m *MotorJoint) InitializeConstraints(step *Step, settings *Settings) {
+	invM1 := m.body1.mass.GetInverseMass()
+	invM2 := m.body2.mass.GetInverseMass()
+	invI1 := m.body1.mass.GetInverseInertia()
+	invI2 := m.body2.mass.GetInverseInertia()
+
+	m.linearMass = invM1 + invM2
+	if m.linearMass > 0 {
+		m.linearMass = 1 / m.linearMass
+	}
+	m.angularMass = invI1 + invI2
+	if m.angularMass > 0 {
+		m.angularMass = 1 / m.angularMass
+	}
+
+	target := m.body1.GetWorldCenter().AddVector2(m.body1.GetWorldVector(m.linearOffset))
+	m.linearError = target.HereToVector2(m.body2.GetWorldCenter())
+	m.angularError = -getRelativeRotation(m.body1, m.body2, -m.angularOffset)
+
+	if settings.IsWarmStartingEnabled() {
+		m.linearImpulse.Multiply(step.GetDeltaTimeRatio())
+		m.angularImpulse *= step.GetDeltaTimeRatio()
+	} else {
+		m.linearImpulse.Zero()
+		m.angularImpulse = 0
+	}
+	m.applyImpulse(m.linearImpulse, m.angularImpulse, m.angularImpulse)
+}
+
+func (m *MotorJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	dt := step.GetDeltaTime()
+	invdt := step.GetInverseDeltaTime()
+
+	Cdot := m.body2.angularVelocity - m.body1.angularVelocity + invdt*m.correctionFactor*m.angularError
+	impulse := -m.angularMass * Cdot
+	oldImpulse := m.angularImpulse
+	maxImpulse := m.maximumTorque * dt
+	m.angularImpulse = geometry.IntervalClamp(m.angularImpulse+impulse, -maxImpulse, maxImpulse)
+	impulse = m.angularImpulse - oldImpulse
+	m.body1.addAngularVelocity(-m.body1.mass.GetInverseInertia() * impulse)
+	m.body2.addAngularVelocity(m.body2.mass.GetInverseInertia() * impulse)
+
+	Cdot1 := m.body1.velocity.HereToVector2(m.body2.velocity).AddVector2(m.linearError.Product(invdt * m.correctionFactor))
+	P := Cdot1.Multiply(-m.linearMass)
+	oldLinearImpulse := geometry.NewVector2FromVector2(m.linearImpulse)
+	m.linearImpulse.AddVector2(P)
+	maxImpulse = m.maximumForce * dt
+	if m.linearImpulse.GetMagnitudeSquared() > maxImpulse*maxImpulse {
+		m.linearImpulse.Normalize()
+		m.linearImpulse.Multiply(maxImpulse)
+	}
+	P = oldLinearImpulse.HereToVector2(m.linearImpulse)
+	m.applyImpulse(P, 0, 0)
+}
+
+func (m *MotorJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	return true
+}
+
+func (m *MotorJoint) GetAnchor1() *geometry.Vector2 {
+	return m.body1.GetWorldCenter()
+}
+
+func (m *MotorJoint) GetAnchor2() *geometry.Vector2 {
+	return m.body2.GetWorldCenter()
+}
+
+func (m *MotorJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return m.linearImpulse.Product(invdt)
+}
+
+func (m *MotorJoint) GetReactionTorque(invdt float64) float64 {
+	return m.angularImpulse * invdt
+}
+
+func (m *MotorJoint) GetLinearOffset() *geometry.Vector2 {
+	return m.linearOffset
+}
+
+func (m *MotorJoint) SetLinearOffset(linearOffset *geometry.Vector2) {
+	if linearOffset == nil {
+		panic("Cannot set a nil linear offset")
+	}
+	m.linearOffset = linearOffset
+	m.wakeBodies()
+}
+
+func (m *MotorJoint) GetAngularOffset() float64 {
+	return m.angularOffset
+}
+
+func (m *MotorJoint) SetAngularOffset(angularOffset float64) {
+	m.angularOffset = angularOffset
+	m.wakeBodies()
+}
+
+func (m *MotorJoint) GetCorrectionFactor() float64 {
+	return m.correctionFactor
+}
+
+func (m *MotorJoint) SetCorrectionFactor(correctionFactor float64) {
+	if correctionFactor < 0 || correctionFactor > 1 {
+		panic("Correction factor must be between 0 and 1 inclusive")
+	}
+	m.correctionFactor = correctionFactor
+	m.wakeBodies()
+}
+
+func (m *MotorJoint) GetMaximumForce() float64 {
+	return m.maximumForce
+}
+
+func (m *MotorJoint) SetMaximumForce(maximumForce float64) {
+	if maximumForce < 0 {
+		panic("Maximum force must not be negative")
+	}
+	m.maximumForce = maximumForce
+	m.wakeBodies()
+}
+
+func (m *MotorJoint) GetMaximumTorque() float64 {
+	return m.maximumTorque
+}
+
+func (m *MotorJoint) SetMaximumTorque(maximumTorque float64) {
+	if maximumTorque < 0 {
+		panic("Maximum torque must not be negative")
+	}
+	m.maximumTorque = maximumTorque
+	m.wakeBodies()
+}
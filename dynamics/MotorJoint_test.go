@@ -0,0 +1,52 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests setting an invalid correction factor.
+ */
+func TestMotorJointCorrectionFactor(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	_, ground, box := createJointTestWorld()
+	j := NewMotorJoint(ground, box)
+	j.SetCorrectionFactor(1.5)
+}
+
+/**
+ * Tests that the motor joint drives the body to the offsets.
+ */
+func TestMotorJointOffset(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	j := NewMotorJoint(ground, box)
+	dyn4go.AssertEqualWithinError(t, 2.0, j.GetLinearOffset().X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 0.0, j.GetAngularOffset(), 1.0e-9)
+	j.SetLinearOffset(geometry.NewVector2FromXY(0.0, 3.0))
+	j.SetAngularOffset(1.0)
+	w.AddJoint(j)
+
+	w.StepN(300)
+	dyn4go.AssertEqualWithinError(t, 0.0, box.GetWorldCenter().X, 0.01)
+	dyn4go.AssertEqualWithinError(t, 3.0, box.GetWorldCenter().Y, 0.01)
+	dyn4go.AssertEqualWithinError(t, 1.0, box.GetTransform().GetRotation(), 0.01)
+}
+
+/**
+ * Tests that the motor joint force is limited.
+ */
+func TestMotorJointMaximumForce(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewMotorJoint(ground, box)
+	j.SetMaximumForce(1.0)
+	w.AddJoint(j)
+
+	w.StepN(30)
+	invdt := w.GetStep().GetInverseDeltaTime()
+	dyn4go.AssertEqualWithinError(t, 1.0, j.GetReactionForce(invdt).GetMagnitude(), 1.0e-9)
+	dyn4go.AssertTrue(t, box.GetWorldCenter().Y < -0.1)
+}
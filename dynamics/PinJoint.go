@@ -0,0 +1,162 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type PinJoint struct {
+	Joint
+	target       *geometry.Vector2
+	localAnchor  *geometry.Vector2
+	frequency    float64
+	dampingRatio float64
+	maximumForce float64
+	K            *geometry.Matrix22
+	gamma        float64
+	C            *geometry.Vector2
+	impulse      *geometry.Vector2
+}
+
+var _ Jointer = new(PinJoint)
+
+func NewPinJoint(body *Body, target *geometry.Vector2, frequency, dampingRatio, maximumForce float64) *PinJoint {
+	if body == nil {
+		panic("Cannot create a pin joint with a nil body")
+	}
+	if target == nil {
+		panic("Cannot create a pin joint with a nil target")
+	}
+	p := new(PinJoint)
+	InitJoint(&p.Joint, body, body, false)
+	p.target = geometry.NewVector2FromVector2(target)
+	p.localAnchor = body.GetLocalPoint(target)
+	p.SetFrequency(frequency)
+	p.SetDampingRatio(dampingRatio)
+	p.SetMaximumForce(maximumForce)
+	p.C = new(geometry.Vector2)
+	p.impulse = new(geometry.Vector2)
+	return p
+}
+
+func (p *PinJoint) InitializeConstraints(step *Step, settings *Settings) {
+	body := p.body2
+	m := body.mass.GetMass()
+	invM := body.mass.GetInverseMass()
+	invI := body.mass.GetInverseInertia()
+	dt := step.GetDeltaTime()
+
+	omega := geometry.TWO_PI * p.frequency
+	d := 2 * m * p.dampingRatio * omega
+	k := m * omega * omega
+	p.gamma = dt * (d + dt*k)
+	if p.gamma != 0 {
+		p.gamma = 1 / p.gamma
+	}
+	beta := dt * k * p.gamma
+
+	r := p.getR2(p.localAnchor)
+	k11 := invM + invI*r.Y*r.Y + p.gamma
+	k12 := -invI * r.X * r.Y
+	k22 := invM + invI*r.X*r.X + p.gamma
+	p.K = geometry.NewMatrix22FromFloats(k11, k12, k12, k22)
+
+	p.C = p.target.HereToVector2(body.GetWorldCenter().AddVector2(r)).Multiply(beta)
+
+	if settings.IsWarmStartingEnabled() {
+		p.impulse.Multiply(step.GetDeltaTimeRatio())
+	} else {
+		p.impulse.Zero()
+	}
+	body.addVelocity(p.impulse.Product(invM), invI*r.CrossVector2(p.impulse))
+}
+
+func (p *PinJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	body := p.body2
+	r := p.getR2(p.localAnchor)
+
+	Cdot := r.CrossZ(body.angularVelocity).AddVector2(body.velocity)
+	J := p.K.Solve(Cdot.AddVector2(p.C).AddVector2(p.impulse.Product(p.gamma))).Negate()
+
+	oldImpulse := geometry.NewVector2FromVector2(p.impulse)
+	p.impulse.AddVector2(J)
+	maxImpulse := p.maximumForce * step.GetDeltaTime()
+	if p.impulse.GetMagnitudeSquared() > maxImpulse*maxImpulse {
+		p.impulse.Normalize()
+		p.impulse.Multiply(maxImpulse)
+	}
+	J = oldImpulse.HereToVector2(p.impulse)
+
+	body.addVelocity(J.Product(body.mass.GetInverseMass()), body.mass.GetInverseInertia()*r.CrossVector2(J))
+}
+
+func (p *PinJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	return true
+}
+
+func (p *PinJoint) GetAnchor1() *geometry.Vector2 {
+	return p.target
+}
+
+func (p *PinJoint) GetAnchor2() *geometry.Vector2 {
+	return p.body2.GetWorldPoint(p.localAnchor)
+}
+
+func (p *PinJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return p.impulse.Product(invdt)
+}
+
+func (p *PinJoint) GetReactionTorque(invdt float64) float64 {
+	return 0
+}
+
+func (p *PinJoint) GetBody() *Body {
+	return p.body2
+}
+
+func (p *PinJoint) GetTarget() *geometry.Vector2 {
+	return p.target
+}
+
+func (p *PinJoint) SetTarget(target *geometry.Vector2) {
+	if target == nil {
+		panic("Cannot set a nil target")
+	}
+	p.target = geometry.NewVector2FromVector2(target)
+	p.wakeBodies()
+}
+
+func (p *PinJoint) GetFrequency() float64 {
+	return p.frequency
+}
+
+func (p *PinJoint) SetFrequency(frequency float64) {
+	if frequency <= 0 {
+		panic("Frequency must be greater than zero")
+	}
+	p.frequency = frequency
+	p.wakeBodies()
+}
+
+func (p *PinJoint) GetDampingRatio() float64 {
+	return p.dampingRatio
+}
+
+func (p *PinJoint) SetDampingRatio(dampingRatio float64) {
+	if dampingRatio < 0 || dampingRatio > 1 {
+		panic("Damping ratio must be between 0 and 1 inclusive")
+	}
+	p.dampingRatio = dampingRatio
+	p.wakeBodies()
+}
+
+func (p *PinJoint) GetMaximumForce() float64 {
+	return p.maximumForce
+}
+
+func (p *PinJoint) SetMaximumForce(maximumForce float64) {
+	if maximumForce < 0 {
+		panic("Maximum force must not be negative")
+	}
+	p.maximumForce = maximumForce
+	p.wakeBodies()
+}
@@ -0,0 +1,75 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests creating a pin joint with a zero frequency.
+ */
+func TestPinJointZeroFrequency(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	_, _, box := createJointTestWorld()
+	NewPinJoint(box, box.GetWorldCenter(), 0.0, 0.7, 1000.0)
+}
+
+/**
+ * Tests that the body follows a moving target.
+ */
+func TestPinJointDrag(t *testing.T) {
+	w, _, box := createJointTestWorld()
+	j := NewPinJoint(box, box.GetWorldCenter(), 5.0, 0.7, 1000.0)
+	w.AddJoint(j)
+	dyn4go.AssertTrue(t, j.GetBody() == box)
+	dyn4go.AssertEqual(t, 1, len(box.GetJoints()))
+
+	target := geometry.NewVector2FromXY(2.0, 0.0)
+	for i := 0; i < 120; i++ {
+		target.AddXY(0.0, 0.025)
+		j.SetTarget(target)
+		w.Step(DEFAULT_STEP_FREQUENCY)
+	}
+	w.StepN(60)
+	dyn4go.AssertEqualWithinError(t, 0.0, target.DistanceFromVector2(j.GetAnchor2()), 0.05)
+
+	w.RemoveJoint(j)
+	dyn4go.AssertEqual(t, 0, len(box.GetJoints()))
+}
+
+/**
+ * Tests that the pin joint force is limited.
+ */
+func TestPinJointMaximumForce(t *testing.T) {
+	w, _, box := createJointTestWorld()
+	weight := box.GetMass().GetMass() * 9.8
+	j := NewPinJoint(box, box.GetWorldCenter(), 5.0, 0.7, weight*0.5)
+	w.AddJoint(j)
+
+	w.StepN(60)
+	invdt := w.GetStep().GetInverseDeltaTime()
+	dyn4go.AssertEqualWithinError(t, weight*0.5, j.GetReactionForce(invdt).GetMagnitude(), 1.0e-9)
+	dyn4go.AssertTrue(t, box.GetWorldCenter().Y < -1.0)
+}
+
+/**
+ * Tests that the accumulated impulse is not applied when warm starting is disabled.
+ */
+func TestPinJointWarmStartDisabled(t *testing.T) {
+	w, _, box := createJointTestWorld()
+	j := NewPinJoint(box, box.GetWorldCenter(), 5.0, 0.7, 1000.0)
+	w.AddJoint(j)
+	w.StepN(30)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).GetMagnitude() > 0)
+
+	settings := w.GetSettings()
+	settings.SetWarmStartingEnabled(false)
+	v := geometry.NewVector2FromVector2(box.GetVelocity())
+	av := box.GetAngularVelocity()
+	j.InitializeConstraints(w.GetStep(), settings)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).IsZero())
+	dyn4go.AssertTrue(t, v.EqualsVector2(box.GetVelocity()))
+	dyn4go.AssertEqual(t, av, box.GetAngularVelocity())
+}
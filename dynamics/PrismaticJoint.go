@@ -0,0 +1,370 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type PrismaticJoint struct {
+	Joint
+	localAnchor1, localAnchor2 *geometry.Vector2
+	xAxis, yAxis               *geometry.Vector2
+	referenceAngle             float64
+	motorEnabled               bool
+	motorSpeed                 float64
+	maximumMotorForce          float64
+	limitEnabled               bool
+	lowerLimit, upperLimit     float64
+	limitState                 int
+	K                          *geometry.Matrix33
+	impulse                    *geometry.Vector3
+	motorImpulse               float64
+	motorMass                  float64
+	axis, perp                 *geometry.Vector2
+	s1, s2, a1, a2             float64
+}
+
+var _ Jointer = new(PrismaticJoint)
+
+func NewPrismaticJoint(body1, body2 *Body, anchor, axis *geometry.Vector2) *PrismaticJoint {
+	if body1 == body2 {
+		panic("Cannot create a prismatic joint between the same body")
+	}
+	if anchor == nil || axis == nil {
+		panic("Cannot create a prismatic joint with a nil anchor or axis")
+	}
+	p := new(PrismaticJoint)
+	InitJoint(&p.Joint, body1, body2, false)
+	p.localAnchor1 = body1.GetLocalPoint(anchor)
+	p.localAnchor2 = body2.GetLocalPoint(anchor)
+	p.xAxis = body1.GetLocalVector(axis.GetNormalized())
+	p.yAxis = p.xAxis.CrossZ(1)
+	p.referenceAngle = body1.transform.GetRotation() - body2.transform.GetRotation()
+	p.limitState = LIMIT_STATE_INACTIVE
+	p.impulse = new(geometry.Vector3)
+	return p
+}
+
+func (p *PrismaticJoint) InitializeConstraints(step *Step, settings *Settings) {
+	linearTolerance := settings.GetLinearTolerance()
+	invM1 := p.body1.mass.GetInverseMass()
+	invM2 := p.body2.mass.GetInverseMass()
+	invI1 := p.body1.mass.GetInverseInertia()
+	invI2 := p.body2.mass.GetInverseInertia()
+
+	r1 := p.getR1(p.localAnchor1)
+	r2 := p.getR2(p.localAnchor2)
+	d := p.body1.GetWorldCenter().AddVector2(r1).HereToVector2(p.body2.GetWorldCenter().AddVector2(r2))
+	dr1 := d.SumVector2(r1)
+
+	p.axis = p.body1.GetWorldVector(p.xAxis)
+	p.a1 = dr1.CrossVector2(p.axis)
+	p.a2 = r2.CrossVector2(p.axis)
+	p.motorMass = invM1 + invM2 + invI1*p.a1*p.a1 + invI2*p.a2*p.a2
+	if p.motorMass > 0 {
+		p.motorMass = 1 / p.motorMass
+	}
+
+	p.perp = p.body1.GetWorldVector(p.yAxis)
+	p.s1 = dr1.CrossVector2(p.perp)
+	p.s2 = r2.CrossVector2(p.perp)
+	p.K = p.getK(invM1, invM2, invI1, invI2)
+
+	if p.limitEnabled {
+		translation := p.axis.DotVector2(d)
+		if math.Abs(p.upperLimit-p.lowerLimit) < 2*linearTolerance {
+			p.limitState = LIMIT_STATE_EQUAL
+		} else if translation <= p.lowerLimit {
+			if p.limitState != LIMIT_STATE_AT_LOWER {
+				p.limitState = LIMIT_STATE_AT_LOWER
+				p.impulse.Z = 0
+			}
+		} else if translation >= p.upperLimit {
+			if p.limitState != LIMIT_STATE_AT_UPPER {
+				p.limitState = LIMIT_STATE_AT_UPPER
+				p.impulse.Z = 0
+			}
+		} else {
+			p.limitState = LIMIT_STATE_INACTIVE
+			p.impulse.Z = 0
+		}
+	} else {
+		p.limitState = LIMIT_STATE_INACTIVE
+		p.impulse.Z = 0
+	}
+
+	if !p.motorEnabled {
+		p.motorImpulse = 0
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		p.impulse.Multiply(step.GetDeltaTimeRatio())
+		p.motorImpulse *= step.GetDeltaTimeRatio()
+	} else {
+		p.impulse.Zero()
+		p.motorImpulse = 0
+	}
+
+	axial := p.motorImpulse + p.impulse.Z
+	P := p.perp.Product(p.impulse.X).AddVector2(p.axis.Product(axial))
+	L1 := p.impulse.X*p.s1 + p.impulse.Y + axial*p.a1
+	L2 := p.impulse.X*p.s2 + p.impulse.Y + axial*p.a2
+	p.applyImpulse(P, L1, L2)
+}
+
+func (p *PrismaticJoint) getK(invM1, invM2, invI1, invI2 float64) *geometry.Matrix33 {
+	k11 := invM1 + invM2 + invI1*p.s1*p.s1 + invI2*p.s2*p.s2
+	k12 := invI1*p.s1 + invI2*p.s2
+	k13 := invI1*p.s1*p.a1 + invI2*p.s2*p.a2
+	k22 := invI1 + invI2
+	if k22 == 0 {
+		k22 = 1
+	}
+	k23 := invI1*p.a1 + invI2*p.a2
+	k33 := invM1 + invM2 + invI1*p.a1*p.a1 + invI2*p.a2*p.a2
+	return geometry.NewMatrix33FromFloats(k11, k12, k13, k12, k22, k23, k13, k23, k33)
+}
+
+func (p *PrismaticJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	v1 := p.body1.velocity
+	v2 := p.body2.velocity
+
+	if p.motorEnabled && p.limitState != LIMIT_STATE_EQUAL {
+		Cdot := p.axis.DotVector2(v1.HereToVector2(v2)) + p.a2*p.body2.angularVelocity - p.a1*p.body1.angularVelocity
+		impulse := p.motorMass * (p.motorSpeed - Cdot)
+		oldImpulse := p.motorImpulse
+		maxImpulse := p.maximumMotorForce * step.GetDeltaTime()
+		p.motorImpulse = geometry.IntervalClamp(p.motorImpulse+impulse, -maxImpulse, maxImpulse)
+		impulse = p.motorImpulse - oldImpulse
+		p.applyImpulse(p.axis.Product(impulse), impulse*p.a1, impulse*p.a2)
+	}
+
+	Cdot1 := geometry.NewVector2FromXY(
+		p.perp.DotVector2(v1.HereToVector2(v2))+p.s2*p.body2.angularVelocity-p.s1*p.body1.angularVelocity,
+		p.body2.angularVelocity-p.body1.angularVelocity)
+
+	if p.limitEnabled && p.limitState != LIMIT_STATE_INACTIVE {
+		Cdot2 := p.axis.DotVector2(v1.HereToVector2(v2)) + p.a2*p.body2.angularVelocity - p.a1*p.body1.angularVelocity
+		f1 := geometry.NewVector3FromVector3(p.impulse)
+		df := p.K.Solve33(geometry.NewVector3FromFloats(-Cdot1.X, -Cdot1.Y, -Cdot2))
+		p.impulse.AddVector3(df)
+		if p.limitState == LIMIT_STATE_AT_LOWER {
+			p.impulse.Z = math.Max(p.impulse.Z, 0)
+		} else if p.limitState == LIMIT_STATE_AT_UPPER {
+			p.impulse.Z = math.Min(p.impulse.Z, 0)
+		}
+		b := Cdot1.Negate().SubtractVector2(p.getLimitColumn().Multiply(p.impulse.Z - f1.Z))
+		f2r := p.K.Solve22(b).AddXY(f1.X, f1.Y)
+		p.impulse.X = f2r.X
+		p.impulse.Y = f2r.Y
+		df = p.impulse.DifferenceVector3(f1)
+
+		P := p.perp.Product(df.X).AddVector2(p.axis.Product(df.Z))
+		L1 := df.X*p.s1 + df.Y + df.Z*p.a1
+		L2 := df.X*p.s2 + df.Y + df.Z*p.a2
+		p.applyImpulse(P, L1, L2)
+	} else {
+		df := p.K.Solve22(Cdot1.Negate())
+		p.impulse.X += df.X
+		p.impulse.Y += df.Y
+		P := p.perp.Product(df.X)
+		L1 := df.X*p.s1 + df.Y
+		L2 := df.X*p.s2 + df.Y
+		p.applyImpulse(P, L1, L2)
+	}
+}
+
+func (p *PrismaticJoint) getLimitColumn() *geometry.Vector2 {
+	invI1 := p.body1.mass.GetInverseInertia()
+	invI2 := p.body2.mass.GetInverseInertia()
+	return geometry.NewVector2FromXY(invI1*p.s1*p.a1+invI2*p.s2*p.a2, invI1*p.a1+invI2*p.a2)
+}
+
+func (p *PrismaticJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	linearTolerance := settings.GetLinearTolerance()
+	angularTolerance := settings.GetAngularTolerance()
+	maxLinearCorrection := settings.GetMaximumLinearCorrection()
+	invM1 := p.body1.mass.GetInverseMass()
+	invM2 := p.body2.mass.GetInverseMass()
+	invI1 := p.body1.mass.GetInverseInertia()
+	invI2 := p.body2.mass.GetInverseInertia()
+
+	r1 := p.getR1(p.localAnchor1)
+	r2 := p.getR2(p.localAnchor2)
+	d := p.body1.GetWorldCenter().AddVector2(r1).HereToVector2(p.body2.GetWorldCenter().AddVector2(r2))
+	dr1 := d.SumVector2(r1)
+
+	p.axis = p.body1.GetWorldVector(p.xAxis)
+	p.a1 = dr1.CrossVector2(p.axis)
+	p.a2 = r2.CrossVector2(p.axis)
+	p.perp = p.body1.GetWorldVector(p.yAxis)
+	p.s1 = dr1.CrossVector2(p.perp)
+	p.s2 = r2.CrossVector2(p.perp)
+
+	C := getRelativeRotation(p.body1, p.body2, p.referenceAngle)
+	C1 := geometry.NewVector2FromXY(p.perp.DotVector2(d), -C)
+	linearError := math.Abs(C1.X)
+	angularError := math.Abs(C1.Y)
+
+	active := false
+	C2 := 0.0
+	if p.limitEnabled {
+		translation := p.axis.DotVector2(d)
+		if math.Abs(p.upperLimit-p.lowerLimit) < 2*linearTolerance {
+			C2 = geometry.IntervalClamp(translation-p.lowerLimit, -maxLinearCorrection, maxLinearCorrection)
+			linearError = math.Max(linearError, math.Abs(translation-p.lowerLimit))
+			active = true
+		} else if translation <= p.lowerLimit {
+			C2 = geometry.IntervalClamp(translation-p.lowerLimit+linearTolerance, -maxLinearCorrection, 0)
+			linearError = math.Max(linearError, p.lowerLimit-translation)
+			active = true
+		} else if translation >= p.upperLimit {
+			C2 = geometry.IntervalClamp(translation-p.upperLimit-linearTolerance, 0, maxLinearCorrection)
+			linearError = math.Max(linearError, translation-p.upperLimit)
+			active = true
+		}
+	}
+
+	K := p.getK(invM1, invM2, invI1, invI2)
+	impulse := new(geometry.Vector3)
+	if active {
+		impulse = K.Solve33(geometry.NewVector3FromFloats(-C1.X, -C1.Y, -C2))
+	} else {
+		impulse1 := K.Solve22(C1.Negate())
+		impulse.X = impulse1.X
+		impulse.Y = impulse1.Y
+	}
+
+	P := p.perp.Product(impulse.X).AddVector2(p.axis.Product(impulse.Z))
+	L1 := impulse.X*p.s1 + impulse.Y + impulse.Z*p.a1
+	L2 := impulse.X*p.s2 + impulse.Y + impulse.Z*p.a2
+
+	p.applyPositionImpulse(P, L1, L2)
+
+	return linearError <= linearTolerance && angularError <= angularTolerance
+}
+
+func (p *PrismaticJoint) GetAnchor1() *geometry.Vector2 {
+	return p.body1.GetWorldPoint(p.localAnchor1)
+}
+
+func (p *PrismaticJoint) GetAnchor2() *geometry.Vector2 {
+	return p.body2.GetWorldPoint(p.localAnchor2)
+}
+
+func (p *PrismaticJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return p.perp.Product(p.impulse.X).AddVector2(p.axis.Product(p.motorImpulse + p.impulse.Z)).Multiply(invdt)
+}
+
+func (p *PrismaticJoint) GetReactionTorque(invdt float64) float64 {
+	return p.impulse.Y * invdt
+}
+
+func (p *PrismaticJoint) GetJointTranslation() float64 {
+	d := p.GetAnchor1().HereToVector2(p.GetAnchor2())
+	return d.DotVector2(p.body1.GetWorldVector(p.xAxis))
+}
+
+func (p *PrismaticJoint) GetJointSpeed() float64 {
+	r1 := p.getR1(p.localAnchor1)
+	r2 := p.getR2(p.localAnchor2)
+	d := p.body1.GetWorldCenter().AddVector2(r1).HereToVector2(p.body2.GetWorldCenter().AddVector2(r2))
+	axis := p.body1.GetWorldVector(p.xAxis)
+	v1 := r1.CrossZ(p.body1.angularVelocity).AddVector2(p.body1.velocity)
+	v2 := r2.CrossZ(p.body2.angularVelocity).AddVector2(p.body2.velocity)
+	return d.DotVector2(axis.CrossZ(p.body1.angularVelocity)) + axis.DotVector2(v1.HereToVector2(v2))
+}
+
+func (p *PrismaticJoint) GetAxis() *geometry.Vector2 {
+	return p.body1.GetWorldVector(p.xAxis)
+}
+
+func (p *PrismaticJoint) IsMotorEnabled() bool {
+	return p.motorEnabled
+}
+
+func (p *PrismaticJoint) SetMotorEnabled(flag bool) {
+	p.motorEnabled = flag
+	p.wakeBodies()
+}
+
+func (p *PrismaticJoint) GetMotorSpeed() float64 {
+	return p.motorSpeed
+}
+
+func (p *PrismaticJoint) SetMotorSpeed(motorSpeed float64) {
+	p.motorSpeed = motorSpeed
+	p.wakeBodies()
+}
+
+func (p *PrismaticJoint) GetMaximumMotorForce() float64 {
+	return p.maximumMotorForce
+}
+
+func (p *PrismaticJoint) SetMaximumMotorForce(maximumMotorForce float64) {
+	if maximumMotorForce < 0 {
+		panic("Maximum motor force must not be negative")
+	}
+	p.maximumMotorForce = maximumMotorForce
+	p.wakeBodies()
+}
+
+func (p *PrismaticJoint) GetMotorForce(invdt float64) float64 {
+	return p.motorImpulse * invdt
+}
+
+func (p *PrismaticJoint) IsLimitEnabled() bool {
+	return p.limitEnabled
+}
+
+func (p *PrismaticJoint) SetLimitEnabled(flag bool) {
+	p.limitEnabled = flag
+	p.wakeBodies()
+}
+
+func (p *PrismaticJoint) GetLowerLimit() float64 {
+	return p.lowerLimit
+}
+
+func (p *PrismaticJoint) SetLowerLimit(lowerLimit float64) {
+	if lowerLimit > p.upperLimit {
+		panic("Lower limit must not be greater than the upper limit")
+	}
+	p.lowerLimit = lowerLimit
+	p.wakeBodies()
+}
+
+func (p *PrismaticJoint) GetUpperLimit() float64 {
+	return p.upperLimit
+}
+
+func (p *PrismaticJoint) SetUpperLimit(upperLimit float64) {
+	if upperLimit < p.lowerLimit {
+		panic("Upper limit must not be less than the lower limit")
+	}
+	p.upperLimit = upperLimit
+	p.wakeBodies()
+}
+
+func (p *PrismaticJoint) SetLimits(lowerLimit, upperLimit float64) {
+	if lowerLimit > upperLimit {
+		panic("Lower limit must not be greater than the upper limit")
+	}
+	p.lowerLimit = lowerLimit
+	p.upperLimit = upperLimit
+	p.wakeBodies()
+}
+
+func (p *PrismaticJoint) GetLimitState() int {
+	return p.limitState
+}
+
+func (p *PrismaticJoint) GetReferenceAngle() float64 {
+	return p.referenceAngle
+}
+
+func (p *PrismaticJoint) SetReferenceAngle(angle float64) {
+	p.referenceAngle = angle
+	p.wakeBodies()
+}
@@ -0,0 +1,107 @@
+package dynamics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests that the body may only translate along the axis.
+ */
+func TestPrismaticJointAxis(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewPrismaticJoint(ground, box, box.GetWorldCenter(), geometry.NewVector2FromXY(1.0, 0.0))
+	w.AddJoint(j)
+	box.SetVelocity(geometry.NewVector2FromXY(1.0, 0.0))
+	box.SetAngularVelocity(1.0)
+
+	w.StepN(60)
+	dyn4go.AssertEqualWithinError(t, 0.0, box.GetWorldCenter().Y, 0.01)
+	dyn4go.AssertEqualWithinError(t, 0.0, box.GetTransform().GetRotation(), 0.01)
+	dyn4go.AssertTrue(t, j.GetJointTranslation() > 0.9)
+	dyn4go.AssertEqualWithinError(t, box.GetVelocity().X, j.GetJointSpeed(), 1.0e-6)
+	f := j.GetReactionForce(w.GetStep().GetInverseDeltaTime())
+	weight := box.GetMass().GetMass() * 9.8
+	dyn4go.AssertEqualWithinError(t, weight, f.Y, weight*0.01)
+}
+
+/**
+ * Tests the translation limits.
+ */
+func TestPrismaticJointLimits(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewPrismaticJoint(ground, box, box.GetWorldCenter(), geometry.NewVector2FromXY(0.0, 1.0))
+	j.SetLimits(-1.0, 1.0)
+	j.SetLimitEnabled(true)
+	w.AddJoint(j)
+
+	w.StepN(120)
+	dyn4go.AssertEqualWithinError(t, -1.0, j.GetJointTranslation(), 2*w.GetSettings().GetLinearTolerance())
+	dyn4go.AssertEqual(t, LIMIT_STATE_AT_LOWER, j.GetLimitState())
+	dyn4go.AssertEqualWithinError(t, 2.0, box.GetWorldCenter().X, 0.01)
+}
+
+/**
+ * Tests that the motor drives the translation speed.
+ */
+func TestPrismaticJointMotor(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	axis := geometry.NewVector2FromXY(1.0, 1.0)
+	j := NewPrismaticJoint(ground, box, box.GetWorldCenter(), axis)
+	j.SetMotorEnabled(true)
+	j.SetMotorSpeed(1.0)
+	j.SetMaximumMotorForce(1000.0)
+	w.AddJoint(j)
+
+	w.StepN(30)
+	dyn4go.AssertEqualWithinError(t, 1.0, j.GetJointSpeed(), 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, math.Sqrt(0.5), box.GetVelocity().X, 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, math.Sqrt(0.5), box.GetVelocity().Y, 1.0e-3)
+}
+
+/**
+ * Tests that accumulated impulses are not applied when warm starting is disabled.
+ */
+func TestPrismaticJointWarmStartDisabled(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewPrismaticJoint(ground, box, box.GetWorldCenter(), geometry.NewVector2FromXY(1.0, 1.0))
+	j.SetMotorEnabled(true)
+	j.SetMotorSpeed(1.0)
+	j.SetMaximumMotorForce(1000.0)
+	w.AddJoint(j)
+	w.StepN(30)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).GetMagnitude() > 0)
+	dyn4go.AssertTrue(t, j.GetMotorForce(w.GetStep().GetInverseDeltaTime()) != 0)
+
+	settings := w.GetSettings()
+	settings.SetWarmStartingEnabled(false)
+	v := geometry.NewVector2FromVector2(box.GetVelocity())
+	av := box.GetAngularVelocity()
+	j.InitializeConstraints(w.GetStep(), settings)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).IsZero())
+	dyn4go.AssertEqual(t, 0.0, j.GetMotorForce(w.GetStep().GetInverseDeltaTime()))
+	dyn4go.AssertTrue(t, v.EqualsVector2(box.GetVelocity()))
+	dyn4go.AssertEqual(t, av, box.GetAngularVelocity())
+
+	w.StepN(30)
+	dyn4go.AssertEqualWithinError(t, 1.0, j.GetJointSpeed(), 1.0e-3)
+}
+
+/**
+ * Tests that the reference angle holds the same relative rotation as on the
+ * revolute joint.
+ */
+func TestPrismaticJointReferenceAngle(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	j := NewPrismaticJoint(ground, box, box.GetWorldCenter(), geometry.NewVector2FromXY(1.0, 0.0))
+	j.SetReferenceAngle(0.5)
+	w.AddJoint(j)
+
+	w.StepN(240)
+	dyn4go.AssertTrue(t, math.Abs(box.GetTransform().GetRotation()+0.5) < 0.01)
+	dyn4go.AssertTrue(t, math.Abs(ground.GetTransform().GetRotation()-box.GetTransform().GetRotation()-j.GetReferenceAngle()) < 0.01)
+}
@@ -0,0 +1,196 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type PulleyJoint struct {
+	Joint
+	groundAnchor1, groundAnchor2 *geometry.Vector2
+	localAnchor1, localAnchor2   *geometry.Vector2
+	ratio                        float64
+	length                       float64
+	invK                         float64
+	impulse                      float64
+	n1, n2                       *geometry.Vector2
+}
+
+var _ Jointer = new(PulleyJoint)
+
+func NewPulleyJoint(body1, body2 *Body, groundAnchor1, groundAnchor2, anchor1, anchor2 *geometry.Vector2) *PulleyJoint {
+	if body1 == body2 {
+		panic("Cannot create a pulley joint between the same body")
+	}
+	if groundAnchor1 == nil || groundAnchor2 == nil {
+		panic("Cannot create a pulley joint with a nil ground anchor")
+	}
+	if anchor1 == nil || anchor2 == nil {
+		panic("Cannot create a pulley joint with a nil anchor")
+	}
+	p := new(PulleyJoint)
+	InitJoint(&p.Joint, body1, body2, true)
+	p.groundAnchor1 = geometry.NewVector2FromVector2(groundAnchor1)
+	p.groundAnchor2 = geometry.NewVector2FromVector2(groundAnchor2)
+	p.localAnchor1 = body1.GetLocalPoint(anchor1)
+	p.localAnchor2 = body2.GetLocalPoint(anchor2)
+	p.ratio = 1.0
+	p.length = groundAnchor1.DistanceFromVector2(anchor1) + groundAnchor2.DistanceFromVector2(anchor2)
+	p.n1 = new(geometry.Vector2)
+	p.n2 = new(geometry.Vector2)
+	return p
+}
+
+func (p *PulleyJoint) InitializeConstraints(step *Step, settings *Settings) {
+	invM1 := p.body1.mass.GetInverseMass()
+	invM2 := p.body2.mass.GetInverseMass()
+	invI1 := p.body1.mass.GetInverseInertia()
+	invI2 := p.body2.mass.GetInverseInertia()
+
+	r1 := p.getR1(p.localAnchor1)
+	r2 := p.getR2(p.localAnchor2)
+	p.n1, p.n2 = p.getAxes(r1, r2, settings.GetLinearTolerance())
+	p.invK = p.getInverseMass(r1, r2, p.n1, p.n2)
+	if p.invK > 0 {
+		p.invK = 1 / p.invK
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		p.impulse *= step.GetDeltaTimeRatio()
+	} else {
+		p.impulse = 0
+	}
+	P1 := p.n1.Product(-p.impulse)
+	P2 := p.n2.Product(-p.ratio * p.impulse)
+	p.body1.addVelocity(P1.Product(invM1), invI1*r1.CrossVector2(P1))
+	p.body2.addVelocity(P2.Product(invM2), invI2*r2.CrossVector2(P2))
+}
+
+func (p *PulleyJoint) getAxes(r1, r2 *geometry.Vector2, linearTolerance float64) (*geometry.Vector2, *geometry.Vector2) {
+	n1 := p.groundAnchor1.HereToVector2(p.body1.GetWorldCenter().AddVector2(r1))
+	n2 := p.groundAnchor2.HereToVector2(p.body2.GetWorldCenter().AddVector2(r2))
+	if n1.Normalize() <= 10*linearTolerance {
+		n1.Zero()
+	}
+	if n2.Normalize() <= 10*linearTolerance {
+		n2.Zero()
+	}
+	return n1, n2
+}
+
+func (p *PulleyJoint) getInverseMass(r1, r2, n1, n2 *geometry.Vector2) float64 {
+	cr1 := r1.CrossVector2(n1)
+	cr2 := r2.CrossVector2(n2)
+	m1 := p.body1.mass.GetInverseMass() + p.body1.mass.GetInverseInertia()*cr1*cr1
+	m2 := p.body2.mass.GetInverseMass() + p.body2.mass.GetInverseInertia()*cr2*cr2
+	return m1 + p.ratio*p.ratio*m2
+}
+
+func (p *PulleyJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	invM1 := p.body1.mass.GetInverseMass()
+	invM2 := p.body2.mass.GetInverseMass()
+	invI1 := p.body1.mass.GetInverseInertia()
+	invI2 := p.body2.mass.GetInverseInertia()
+
+	r1 := p.getR1(p.localAnchor1)
+	r2 := p.getR2(p.localAnchor2)
+	v1 := r1.CrossZ(p.body1.angularVelocity).AddVector2(p.body1.velocity)
+	v2 := r2.CrossZ(p.body2.angularVelocity).AddVector2(p.body2.velocity)
+
+	Cdot := -p.n1.DotVector2(v1) - p.ratio*p.n2.DotVector2(v2)
+	impulse := -p.invK * Cdot
+	p.impulse += impulse
+
+	P1 := p.n1.Product(-impulse)
+	P2 := p.n2.Product(-p.ratio * impulse)
+	p.body1.addVelocity(P1.Product(invM1), invI1*r1.CrossVector2(P1))
+	p.body2.addVelocity(P2.Product(invM2), invI2*r2.CrossVector2(P2))
+}
+
+func (p *PulleyJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	linearTolerance := settings.GetLinearTolerance()
+	invM1 := p.body1.mass.GetInverseMass()
+	invM2 := p.body2.mass.GetInverseMass()
+	invI1 := p.body1.mass.GetInverseInertia()
+	invI2 := p.body2.mass.GetInverseInertia()
+
+	r1 := p.getR1(p.localAnchor1)
+	r2 := p.getR2(p.localAnchor2)
+	length1 := p.groundAnchor1.DistanceFromVector2(p.body1.GetWorldCenter().AddVector2(r1))
+	length2 := p.groundAnchor2.DistanceFromVector2(p.body2.GetWorldCenter().AddVector2(r2))
+	n1, n2 := p.getAxes(r1, r2, linearTolerance)
+
+	invMass := p.getInverseMass(r1, r2, n1, n2)
+	mass := 0.0
+	if invMass > 0 {
+		mass = 1 / invMass
+	}
+
+	C := p.length - length1 - p.ratio*length2
+	impulse := -mass * C
+
+	P1 := n1.Product(-impulse)
+	P2 := n2.Product(-p.ratio * impulse)
+	p.body1.correctPosition(P1.Product(invM1), invI1*r1.CrossVector2(P1))
+	p.body2.correctPosition(P2.Product(invM2), invI2*r2.CrossVector2(P2))
+
+	return math.Abs(C) < linearTolerance
+}
+
+func (p *PulleyJoint) GetAnchor1() *geometry.Vector2 {
+	return p.body1.GetWorldPoint(p.localAnchor1)
+}
+
+func (p *PulleyJoint) GetAnchor2() *geometry.Vector2 {
+	return p.body2.GetWorldPoint(p.localAnchor2)
+}
+
+func (p *PulleyJoint) GetPulleyAnchor1() *geometry.Vector2 {
+	return p.groundAnchor1
+}
+
+func (p *PulleyJoint) GetPulleyAnchor2() *geometry.Vector2 {
+	return p.groundAnchor2
+}
+
+func (p *PulleyJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return p.n2.Product(-p.ratio * p.impulse * invdt)
+}
+
+func (p *PulleyJoint) GetReactionTorque(invdt float64) float64 {
+	return 0
+}
+
+func (p *PulleyJoint) GetLength() float64 {
+	return p.length
+}
+
+func (p *PulleyJoint) SetLength(length float64) {
+	if length < 0 {
+		panic("Length must not be negative")
+	}
+	p.length = length
+	p.wakeBodies()
+}
+
+func (p *PulleyJoint) GetCurrentLength1() float64 {
+	return p.groundAnchor1.DistanceFromVector2(p.GetAnchor1())
+}
+
+func (p *PulleyJoint) GetCurrentLength2() float64 {
+	return p.groundAnchor2.DistanceFromVector2(p.GetAnchor2())
+}
+
+func (p *PulleyJoint) GetRatio() float64 {
+	return p.ratio
+}
+
+func (p *PulleyJoint) SetRatio(ratio float64) {
+	if ratio <= 0 {
+		panic("Ratio must be greater than zero")
+	}
+	p.ratio = ratio
+	p.length = p.GetCurrentLength1() + ratio*p.GetCurrentLength2()
+	p.wakeBodies()
+}
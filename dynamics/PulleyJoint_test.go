@@ -0,0 +1,93 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests setting a non-positive ratio.
+ */
+func TestPulleyJointInvalidRatio(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b1.TranslateXY(-1.0, -2.0)
+	b2.TranslateXY(1.0, -2.0)
+	j := NewPulleyJoint(b1, b2, geometry.NewVector2FromXY(-1.0, 0.0), geometry.NewVector2FromXY(1.0, 0.0), b1.GetWorldCenter(), b2.GetWorldCenter())
+	j.SetRatio(0.0)
+}
+
+/**
+ * Tests that equal masses balance on the pulley.
+ */
+func TestPulleyJointBalanced(t *testing.T) {
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b1.TranslateXY(-1.0, -2.0)
+	b2.TranslateXY(1.0, -2.0)
+	w := NewWorld()
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j := NewPulleyJoint(b1, b2, geometry.NewVector2FromXY(-1.0, 0.0), geometry.NewVector2FromXY(1.0, 0.0), b1.GetWorldCenter(), b2.GetWorldCenter())
+	dyn4go.AssertEqualWithinError(t, 4.0, j.GetLength(), 1.0e-9)
+	w.AddJoint(j)
+
+	w.StepN(60)
+	dyn4go.AssertEqualWithinError(t, -2.0, b1.GetWorldCenter().Y, 0.01)
+	dyn4go.AssertEqualWithinError(t, -2.0, b2.GetWorldCenter().Y, 0.01)
+	weight := b2.GetMass().GetMass() * 9.8
+	dyn4go.AssertEqualWithinError(t, weight, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).GetMagnitude(), weight*0.01)
+}
+
+/**
+ * Tests that the total length is kept when one side is heavier.
+ */
+func TestPulleyJointRatio(t *testing.T) {
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b1.TranslateXY(-1.0, -2.0)
+	b2.TranslateXY(1.0, -2.0)
+	w := NewWorld()
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j := NewPulleyJoint(b1, b2, geometry.NewVector2FromXY(-1.0, 0.0), geometry.NewVector2FromXY(1.0, 0.0), b1.GetWorldCenter(), b2.GetWorldCenter())
+	j.SetRatio(2.0)
+	dyn4go.AssertEqualWithinError(t, 6.0, j.GetLength(), 1.0e-9)
+	w.AddJoint(j)
+
+	for i := 0; i < 30; i++ {
+		w.Step(DEFAULT_STEP_FREQUENCY)
+		dyn4go.AssertEqualWithinError(t, j.GetLength(), j.GetCurrentLength1()+2.0*j.GetCurrentLength2(), 0.01)
+	}
+	dyn4go.AssertTrue(t, b1.GetWorldCenter().Y < -2.0)
+	dyn4go.AssertTrue(t, b2.GetWorldCenter().Y > -2.0)
+}
+
+/**
+ * Tests that the accumulated impulse is not applied when warm starting is disabled.
+ */
+func TestPulleyJointWarmStartDisabled(t *testing.T) {
+	b1 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b2 := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	b1.TranslateXY(-1.0, -2.0)
+	b2.TranslateXY(1.0, -2.0)
+	w := NewWorld()
+	w.AddBody(b1)
+	w.AddBody(b2)
+	j := NewPulleyJoint(b1, b2, geometry.NewVector2FromXY(-1.0, 0.0), geometry.NewVector2FromXY(1.0, 0.0), b1.GetWorldCenter(), b2.GetWorldCenter())
+	w.AddJoint(j)
+	w.StepN(30)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).GetMagnitude() > 0)
+
+	settings := w.GetSettings()
+	settings.SetWarmStartingEnabled(false)
+	v1 := geometry.NewVector2FromVector2(b1.GetVelocity())
+	v2 := geometry.NewVector2FromVector2(b2.GetVelocity())
+	j.InitializeConstraints(w.GetStep(), settings)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).IsZero())
+	dyn4go.AssertTrue(t, v1.EqualsVector2(b1.GetVelocity()))
+	dyn4go.AssertTrue(t, v2.EqualsVector2(b2.GetVelocity()))
+}
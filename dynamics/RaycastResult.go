@@ -0,0 +1,64 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/collision/narrowphase"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+const (
+	RAYCAST_MODE_ALL = iota
+	RAYCAST_MODE_CLOSEST
+	RAYCAST_MODE_ANY
+)
+
+type RaycastResult struct {
+	body    *Body
+	fixture *BodyFixture
+	raycast *narrowphase.Raycast
+}
+
+func NewRaycastResult(body *Body, fixture *BodyFixture, raycast *narrowphase.Raycast) *RaycastResult {
+	r := new(RaycastResult)
+	r.body = body
+	r.fixture = fixture
+	r.raycast = raycast
+	return r
+}
+
+func (r *RaycastResult) GetBody() *Body {
+	return r.body
+}
+
+func (r *RaycastResult) GetFixture() *BodyFixture {
+	return r.fixture
+}
+
+func (r *RaycastResult) GetRaycast() *narrowphase.Raycast {
+	return r.raycast
+}
+
+func (r *RaycastResult) GetPoint() *geometry.Vector2 {
+	return r.raycast.GetPoint()
+}
+
+func (r *RaycastResult) GetNormal() *geometry.Vector2 {
+	return r.raycast.GetNormal()
+}
+
+func (r *RaycastResult) GetDistance() float64 {
+	return r.raycast.GetDistance()
+}
+
+type raycastResultList []*RaycastResult
+
+func (r raycastResultList) Len() int {
+	return len(r)
+}
+
+func (r raycastResultList) Less(i, j int) bool {
+	return r[i].raycast.GetDistance() < r[j].raycast.GetDistance()
+}
+
+func (r raycastResultList) Swap(i, j int) {
+	r[i], r[j] = r[j], r[i]
+}
@@ -0,0 +1,322 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type RevoluteJoint struct {
+	Joint
+	localAnchor1, localAnchor2 *geometry.Vector2
+	motorEnabled               bool
+	motorSpeed                 float64
+	maximumMotorTorque         float64
+	limitEnabled               bool
+	lowerLimit, upperLimit     float64
+	referenceAngle             float64
+	limitState                 int
+	K                          *geometry.Matrix33
+	motorMass                  float64
+	impulse                    *geometry.Vector3
+	motorImpulse               float64
+	r1, r2                     *geometry.Vector2
+}
+
+var _ Jointer = new(RevoluteJoint)
+
+func NewRevoluteJoint(body1, body2 *Body, anchor *geometry.Vector2) *RevoluteJoint {
+	if body1 == body2 {
+		panic("Cannot create a revolute joint between the same body")
+	}
+	if anchor == nil {
+		panic("Cannot create a revolute joint with a nil anchor")
+	}
+	r := new(RevoluteJoint)
+	InitJoint(&r.Joint, body1, body2, false)
+	r.localAnchor1 = body1.GetLocalPoint(anchor)
+	r.localAnchor2 = body2.GetLocalPoint(anchor)
+	r.referenceAngle = body1.transform.GetRotation() - body2.transform.GetRotation()
+	r.limitState = LIMIT_STATE_INACTIVE
+	r.impulse = new(geometry.Vector3)
+	return r
+}
+
+func (r *RevoluteJoint) InitializeConstraints(step *Step, settings *Settings) {
+	angularTolerance := settings.GetAngularTolerance()
+	t1 := r.body1.transform
+	t2 := r.body2.transform
+	m1 := r.body1.mass
+	m2 := r.body2.mass
+	invM1 := m1.GetInverseMass()
+	invM2 := m2.GetInverseMass()
+	invI1 := m1.GetInverseInertia()
+	invI2 := m2.GetInverseInertia()
+
+	r.r1 = t1.GetTransformedR(r.body1.GetLocalCenter().HereToVector2(r.localAnchor1))
+	r.r2 = t2.GetTransformedR(r.body2.GetLocalCenter().HereToVector2(r.localAnchor2))
+
+	m00 := invM1 + invM2 + r.r1.Y*r.r1.Y*invI1 + r.r2.Y*r.r2.Y*invI2
+	m01 := -r.r1.Y*r.r1.X*invI1 - r.r2.Y*r.r2.X*invI2
+	m02 := -r.r1.Y*invI1 - r.r2.Y*invI2
+	m11 := invM1 + invM2 + r.r1.X*r.r1.X*invI1 + r.r2.X*r.r2.X*invI2
+	m12 := r.r1.X*invI1 + r.r2.X*invI2
+	m22 := invI1 + invI2
+	r.K = geometry.NewMatrix33FromFloats(m00, m01, m02, m01, m11, m12, m02, m12, m22)
+
+	r.motorMass = 0
+	if m22 > dyn4go.Epsilon {
+		r.motorMass = 1 / m22
+	}
+
+	if !r.motorEnabled {
+		r.motorImpulse = 0
+	}
+
+	if r.limitEnabled && m22 > dyn4go.Epsilon {
+		angle := getRelativeRotation(r.body1, r.body2, r.referenceAngle)
+		if math.Abs(r.upperLimit-r.lowerLimit) < 2*angularTolerance {
+			r.limitState = LIMIT_STATE_EQUAL
+		} else if angle <= r.lowerLimit {
+			if r.limitState != LIMIT_STATE_AT_LOWER {
+				r.impulse.Z = 0
+			}
+			r.limitState = LIMIT_STATE_AT_LOWER
+		} else if angle >= r.upperLimit {
+			if r.limitState != LIMIT_STATE_AT_UPPER {
+				r.impulse.Z = 0
+			}
+			r.limitState = LIMIT_STATE_AT_UPPER
+		} else {
+			r.impulse.Z = 0
+			r.limitState = LIMIT_STATE_INACTIVE
+		}
+	} else {
+		r.impulse.Z = 0
+		r.limitState = LIMIT_STATE_INACTIVE
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		r.impulse.Multiply(step.GetDeltaTimeRatio())
+		r.motorImpulse *= step.GetDeltaTimeRatio()
+	} else {
+		r.impulse.Zero()
+		r.motorImpulse = 0
+	}
+
+	P := geometry.NewVector2FromXY(r.impulse.X, r.impulse.Y)
+	r.applyImpulse(P, r.r1.CrossVector2(P)+r.motorImpulse+r.impulse.Z, r.r2.CrossVector2(P)+r.motorImpulse+r.impulse.Z)
+}
+
+func (r *RevoluteJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	if r.motorEnabled && r.limitState != LIMIT_STATE_EQUAL {
+		Cdt := r.body2.angularVelocity - r.body1.angularVelocity + r.motorSpeed
+		impulse := r.motorMass * -Cdt
+		oldImpulse := r.motorImpulse
+		maxImpulse := r.maximumMotorTorque * step.GetDeltaTime()
+		r.motorImpulse = geometry.IntervalClamp(r.motorImpulse+impulse, -maxImpulse, maxImpulse)
+		impulse = r.motorImpulse - oldImpulse
+		r.applyImpulse(new(geometry.Vector2), impulse, impulse)
+	}
+
+	v1 := r.r1.CrossZ(r.body1.angularVelocity).AddVector2(r.body1.velocity)
+	v2 := r.r2.CrossZ(r.body2.angularVelocity).AddVector2(r.body2.velocity)
+	pivotV := v1.HereToVector2(v2)
+
+	if r.limitEnabled && r.limitState != LIMIT_STATE_INACTIVE {
+		limitV := r.body2.angularVelocity - r.body1.angularVelocity
+		impulse := r.K.Solve33(geometry.NewVector3FromFloats(pivotV.X, pivotV.Y, limitV)).Negate()
+		if r.limitState == LIMIT_STATE_EQUAL {
+			r.impulse.AddVector3(impulse)
+		} else if r.limitState == LIMIT_STATE_AT_LOWER && r.impulse.Z+impulse.Z > 0 || r.limitState == LIMIT_STATE_AT_UPPER && r.impulse.Z+impulse.Z < 0 {
+			rhs := r.getLimitColumn().Multiply(r.impulse.Z).SubtractVector2(pivotV)
+			reduced := r.K.Solve22(rhs)
+			impulse.X = reduced.X
+			impulse.Y = reduced.Y
+			impulse.Z = -r.impulse.Z
+			r.impulse.X += reduced.X
+			r.impulse.Y += reduced.Y
+			r.impulse.Z = 0
+		} else {
+			r.impulse.AddVector3(impulse)
+		}
+		P := geometry.NewVector2FromXY(impulse.X, impulse.Y)
+		r.applyImpulse(P, r.r1.CrossVector2(P)+impulse.Z, r.r2.CrossVector2(P)+impulse.Z)
+	} else {
+		impulse := r.K.Solve22(pivotV).Negate()
+		r.impulse.X += impulse.X
+		r.impulse.Y += impulse.Y
+		r.applyImpulse(impulse, r.r1.CrossVector2(impulse), r.r2.CrossVector2(impulse))
+	}
+}
+
+func (r *RevoluteJoint) getLimitColumn() *geometry.Vector2 {
+	invI1 := r.body1.mass.GetInverseInertia()
+	invI2 := r.body2.mass.GetInverseInertia()
+	return geometry.NewVector2FromXY(-r.r1.Y*invI1-r.r2.Y*invI2, r.r1.X*invI1+r.r2.X*invI2)
+}
+
+func (r *RevoluteJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	linearTolerance := settings.GetLinearTolerance()
+	angularTolerance := settings.GetAngularTolerance()
+	maxAngularCorrection := settings.GetMaximumAngularCorrection()
+	m1 := r.body1.mass
+	m2 := r.body2.mass
+	invM1 := m1.GetInverseMass()
+	invM2 := m2.GetInverseMass()
+	invI1 := m1.GetInverseInertia()
+	invI2 := m2.GetInverseInertia()
+
+	angularError := 0.0
+	if r.limitEnabled && r.limitState != LIMIT_STATE_INACTIVE {
+		angle := getRelativeRotation(r.body1, r.body2, r.referenceAngle)
+		limitImpulse := 0.0
+		if r.limitState == LIMIT_STATE_EQUAL {
+			j := geometry.IntervalClamp(angle-r.lowerLimit, -maxAngularCorrection, maxAngularCorrection)
+			limitImpulse = j * r.motorMass
+			angularError = math.Abs(j)
+		} else if r.limitState == LIMIT_STATE_AT_LOWER {
+			j := angle - r.lowerLimit
+			angularError = -j
+			j = geometry.IntervalClamp(j+angularTolerance, -maxAngularCorrection, 0)
+			limitImpulse = j * r.motorMass
+		} else if r.limitState == LIMIT_STATE_AT_UPPER {
+			j := angle - r.upperLimit
+			angularError = j
+			j = geometry.IntervalClamp(j-angularTolerance, 0, maxAngularCorrection)
+			limitImpulse = j * r.motorMass
+		}
+		r.applyPositionImpulse(new(geometry.Vector2), limitImpulse, limitImpulse)
+	}
+
+	r1 := r.body1.transform.GetTransformedR(r.body1.GetLocalCenter().HereToVector2(r.localAnchor1))
+	r2 := r.body2.transform.GetTransformedR(r.body2.GetLocalCenter().HereToVector2(r.localAnchor2))
+	p1 := r.body1.GetWorldCenter().AddVector2(r1)
+	p2 := r.body2.GetWorldCenter().AddVector2(r2)
+	p := p1.HereToVector2(p2)
+	linearError := p.GetMagnitude()
+
+	K := geometry.NewMatrix22FromFloats(
+		invM1+invM2+r1.Y*r1.Y*invI1+r2.Y*r2.Y*invI2,
+		-invI1*r1.X*r1.Y-invI2*r2.X*r2.Y,
+		-invI1*r1.X*r1.Y-invI2*r2.X*r2.Y,
+		invM1+invM2+r1.X*r1.X*invI1+r2.X*r2.X*invI2)
+	J := K.Solve(p).Negate()
+	r.applyPositionImpulse(J, r1.CrossVector2(J), r2.CrossVector2(J))
+
+	return linearError <= linearTolerance && angularError <= angularTolerance
+}
+
+func (r *RevoluteJoint) GetAnchor1() *geometry.Vector2 {
+	return r.body1.GetWorldPoint(r.localAnchor1)
+}
+
+func (r *RevoluteJoint) GetAnchor2() *geometry.Vector2 {
+	return r.body2.GetWorldPoint(r.localAnchor2)
+}
+
+func (r *RevoluteJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return geometry.NewVector2FromXY(r.impulse.X*invdt, r.impulse.Y*invdt)
+}
+
+func (r *RevoluteJoint) GetReactionTorque(invdt float64) float64 {
+	return r.impulse.Z * invdt
+}
+
+func (r *RevoluteJoint) GetJointSpeed() float64 {
+	return r.body1.angularVelocity - r.body2.angularVelocity
+}
+
+func (r *RevoluteJoint) GetJointAngle() float64 {
+	return getRelativeRotation(r.body1, r.body2, r.referenceAngle)
+}
+
+func (r *RevoluteJoint) IsMotorEnabled() bool {
+	return r.motorEnabled
+}
+
+func (r *RevoluteJoint) SetMotorEnabled(flag bool) {
+	r.motorEnabled = flag
+	r.wakeBodies()
+}
+
+func (r *RevoluteJoint) GetMotorSpeed() float64 {
+	return r.motorSpeed
+}
+
+func (r *RevoluteJoint) SetMotorSpeed(motorSpeed float64) {
+	r.motorSpeed = motorSpeed
+	r.wakeBodies()
+}
+
+func (r *RevoluteJoint) GetMaximumMotorTorque() float64 {
+	return r.maximumMotorTorque
+}
+
+func (r *RevoluteJoint) SetMaximumMotorTorque(maximumMotorTorque float64) {
+	if maximumMotorTorque < 0 {
+		panic("Maximum motor torque must not be negative")
+	}
+	r.maximumMotorTorque = maximumMotorTorque
+	r.wakeBodies()
+}
+
+func (r *RevoluteJoint) GetMotorTorque(invdt float64) float64 {
+	return -r.motorImpulse * invdt
+}
+
+func (r *RevoluteJoint) IsLimitEnabled() bool {
+	return r.limitEnabled
+}
+
+func (r *RevoluteJoint) SetLimitEnabled(flag bool) {
+	r.limitEnabled = flag
+	r.wakeBodies()
+}
+
+func (r *RevoluteJoint) GetLowerLimit() float64 {
+	return r.lowerLimit
+}
+
+func (r *RevoluteJoint) SetLowerLimit(lowerLimit float64) {
+	if lowerLimit > r.upperLimit {
+		panic("Lower limit must not be greater than the upper limit")
+	}
+	r.lowerLimit = lowerLimit
+	r.wakeBodies()
+}
+
+func (r *RevoluteJoint) GetUpperLimit() float64 {
+	return r.upperLimit
+}
+
+func (r *RevoluteJoint) SetUpperLimit(upperLimit float64) {
+	if upperLimit < r.lowerLimit {
+		panic("Upper limit must not be less than the lower limit")
+	}
+	r.upperLimit = upperLimit
+	r.wakeBodies()
+}
+
+func (r *RevoluteJoint) SetLimits(lowerLimit, upperLimit float64) {
+	if lowerLimit > upperLimit {
+		panic("Lower limit must not be greater than the upper limit")
+	}
+	r.lowerLimit = lowerLimit
+	r.upperLimit = upperLimit
+	r.wakeBodies()
+}
+
+func (r *RevoluteJoint) GetLimitState() int {
+	return r.limitState
+}
+
+func (r *RevoluteJoint) GetReferenceAngle() float64 {
+	return r.referenceAngle
+}
+
+func (r *RevoluteJoint) SetReferenceAngle(angle float64) {
+	r.referenceAngle = angle
+	r.wakeBodies()
+}
@@ -0,0 +1,132 @@
+package dynamics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func createJointTestWorld() (*World, *Body, *Body) {
+	w := NewWorld()
+	ground := createWorldTestBody(geometry.CreateCircle(0.1), geometry.INFINITE)
+	box := createWorldTestBody(geometry.CreateSquare(0.5), geometry.NORMAL)
+	box.TranslateXY(2.0, 0.0)
+	w.AddBody(ground)
+	w.AddBody(box)
+	return w, ground, box
+}
+
+/**
+ * Tests creating a revolute joint between the same body.
+ */
+func TestRevoluteJointSameBody(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	b := NewBody()
+	NewRevoluteJoint(b, b, new(geometry.Vector2))
+}
+
+/**
+ * Tests that a pendulum stays pinned at the anchor.
+ */
+func TestRevoluteJointPendulum(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewRevoluteJoint(ground, box, new(geometry.Vector2))
+	w.AddJoint(j)
+	dyn4go.AssertTrue(t, ground.IsConnected(box))
+	dyn4go.AssertEqual(t, 1, w.GetJointCount())
+
+	for i := 0; i < 120; i++ {
+		w.Step(DEFAULT_STEP_FREQUENCY)
+		dyn4go.AssertEqualWithinError(t, 0.0, j.GetAnchor1().DistanceFromVector2(j.GetAnchor2()), 0.01)
+		dyn4go.AssertEqualWithinError(t, 2.0, box.GetWorldCenter().GetMagnitude(), 0.01)
+	}
+	dyn4go.AssertTrue(t, box.GetWorldCenter().Y < 0)
+
+	w.RemoveBody(box)
+	dyn4go.AssertEqual(t, 0, w.GetJointCount())
+	dyn4go.AssertFalse(t, ground.IsConnected(box))
+}
+
+/**
+ * Tests that the angle limits are enforced.
+ */
+func TestRevoluteJointLimits(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewRevoluteJoint(ground, box, new(geometry.Vector2))
+	j.SetLimits(-math.Pi/8, math.Pi/8)
+	j.SetLimitEnabled(true)
+	w.AddJoint(j)
+
+	w.StepN(120)
+	tolerance := 2 * w.GetSettings().GetAngularTolerance()
+	dyn4go.AssertEqualWithinError(t, math.Pi/8, j.GetJointAngle(), tolerance)
+	dyn4go.AssertEqual(t, LIMIT_STATE_AT_UPPER, j.GetLimitState())
+	dyn4go.AssertTrue(t, j.GetReactionTorque(w.GetStep().GetInverseDeltaTime()) != 0)
+}
+
+/**
+ * Tests that the motor drives the relative angular velocity.
+ */
+func TestRevoluteJointMotor(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	j := NewRevoluteJoint(ground, box, box.GetWorldCenter())
+	j.SetMotorEnabled(true)
+	j.SetMotorSpeed(2.0)
+	j.SetMaximumMotorTorque(1000.0)
+	w.AddJoint(j)
+
+	w.StepN(10)
+	dyn4go.AssertEqualWithinError(t, 2.0, j.GetJointSpeed(), 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, -2.0, box.GetAngularVelocity(), 1.0e-3)
+
+	j.SetMaximumMotorTorque(0.0)
+	box.SetAngularDamping(0.0)
+	w.StepN(10)
+	dyn4go.AssertEqualWithinError(t, -2.0, box.GetAngularVelocity(), 1.0e-3)
+}
+
+/**
+ * Tests the reaction force of a hanging body.
+ */
+func TestRevoluteJointReactionForce(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	box.TranslateXY(-2.0, -2.0)
+	j := NewRevoluteJoint(ground, box, new(geometry.Vector2))
+	w.AddJoint(j)
+
+	w.StepN(60)
+	f := j.GetReactionForce(w.GetStep().GetInverseDeltaTime())
+	weight := box.GetMass().GetMass() * 9.8
+	dyn4go.AssertEqualWithinError(t, weight, f.GetMagnitude(), weight*0.01)
+}
+
+/**
+ * Tests that accumulated impulses are not applied when warm starting is disabled.
+ */
+func TestRevoluteJointWarmStartDisabled(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewRevoluteJoint(ground, box, new(geometry.Vector2))
+	j.SetMotorEnabled(true)
+	j.SetMotorSpeed(1.0)
+	j.SetMaximumMotorTorque(10.0)
+	w.AddJoint(j)
+	w.StepN(30)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).GetMagnitude() > 0)
+	dyn4go.AssertTrue(t, j.GetMotorTorque(w.GetStep().GetInverseDeltaTime()) != 0)
+
+	settings := w.GetSettings()
+	settings.SetWarmStartingEnabled(false)
+	v := geometry.NewVector2FromVector2(box.GetVelocity())
+	av := box.GetAngularVelocity()
+	j.InitializeConstraints(w.GetStep(), settings)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).IsZero())
+	dyn4go.AssertEqual(t, 0.0, j.GetMotorTorque(w.GetStep().GetInverseDeltaTime()))
+	dyn4go.AssertTrue(t, v.EqualsVector2(box.GetVelocity()))
+	dyn4go.AssertEqual(t, av, box.GetAngularVelocity())
+
+	w.StepN(60)
+	dyn4go.AssertTrue(t, math.Abs(j.GetAnchor1().DistanceFromVector2(j.GetAnchor2())) < 0.01)
+}
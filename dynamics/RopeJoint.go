@@ -0,0 +1,140 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type RopeJoint struct {
+	Joint
+	localAnchor1, localAnchor2 *geometry.Vector2
+	maximumLength              float64
+	length                     float64
+	invK                       float64
+	impulse                    float64
+	n                          *geometry.Vector2
+}
+
+var _ Jointer = new(RopeJoint)
+
+func NewRopeJoint(body1, body2 *Body, anchor1, anchor2 *geometry.Vector2) *RopeJoint {
+	if body1 == body2 {
+		panic("Cannot create a rope joint between the same body")
+	}
+	if anchor1 == nil || anchor2 == nil {
+		panic("Cannot create a rope joint with a nil anchor")
+	}
+	r := new(RopeJoint)
+	InitJoint(&r.Joint, body1, body2, false)
+	r.localAnchor1 = body1.GetLocalPoint(anchor1)
+	r.localAnchor2 = body2.GetLocalPoint(anchor2)
+	r.maximumLength = anchor1.DistanceFromVector2(anchor2)
+	r.n = new(geometry.Vector2)
+	return r
+}
+
+func (r *RopeJoint) InitializeConstraints(step *Step, settings *Settings) {
+	linearTolerance := settings.GetLinearTolerance()
+	invM1 := r.body1.mass.GetInverseMass()
+	invM2 := r.body2.mass.GetInverseMass()
+	invI1 := r.body1.mass.GetInverseInertia()
+	invI2 := r.body2.mass.GetInverseInertia()
+
+	r1 := r.getR1(r.localAnchor1)
+	r2 := r.getR2(r.localAnchor2)
+	r.n = r.body1.GetWorldCenter().AddVector2(r1).HereToVector2(r.body2.GetWorldCenter().AddVector2(r2))
+	r.length = r.n.GetMagnitude()
+
+	if r.length <= linearTolerance {
+		r.n.Zero()
+		r.invK = 0
+		r.impulse = 0
+		return
+	}
+	r.n.Multiply(1 / r.length)
+
+	cr1 := r1.CrossVector2(r.n)
+	cr2 := r2.CrossVector2(r.n)
+	invMass := invM1 + invI1*cr1*cr1 + invM2 + invI2*cr2*cr2
+	r.invK = 0
+	if invMass != 0 {
+		r.invK = 1 / invMass
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		r.impulse *= step.GetDeltaTimeRatio()
+	} else {
+		r.impulse = 0
+	}
+	P := r.n.Product(r.impulse)
+	r.applyImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+}
+
+func (r *RopeJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	r1 := r.getR1(r.localAnchor1)
+	r2 := r.getR2(r.localAnchor2)
+	v1 := r1.CrossZ(r.body1.angularVelocity).AddVector2(r.body1.velocity)
+	v2 := r2.CrossZ(r.body2.angularVelocity).AddVector2(r.body2.velocity)
+	C := r.length - r.maximumLength
+	Cdot := r.n.DotVector2(v1.HereToVector2(v2))
+	if C < 0 {
+		Cdot += step.GetInverseDeltaTime() * C
+	}
+
+	impulse := -r.invK * Cdot
+	oldImpulse := r.impulse
+	r.impulse = math.Min(0, r.impulse+impulse)
+	impulse = r.impulse - oldImpulse
+	P := r.n.Product(impulse)
+	r.applyImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+}
+
+func (r *RopeJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	linearTolerance := settings.GetLinearTolerance()
+	maxLinearCorrection := settings.GetMaximumLinearCorrection()
+
+	r1 := r.getR1(r.localAnchor1)
+	r2 := r.getR2(r.localAnchor2)
+	n := r.body1.GetWorldCenter().AddVector2(r1).HereToVector2(r.body2.GetWorldCenter().AddVector2(r2))
+	length := n.Normalize()
+	C := geometry.IntervalClamp(length-r.maximumLength, 0, maxLinearCorrection)
+
+	impulse := -r.invK * C
+	P := n.Product(impulse)
+	r.applyPositionImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+
+	return length-r.maximumLength < linearTolerance
+}
+
+func (r *RopeJoint) GetAnchor1() *geometry.Vector2 {
+	return r.body1.GetWorldPoint(r.localAnchor1)
+}
+
+func (r *RopeJoint) GetAnchor2() *geometry.Vector2 {
+	return r.body2.GetWorldPoint(r.localAnchor2)
+}
+
+func (r *RopeJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return r.n.Product(r.impulse * invdt)
+}
+
+func (r *RopeJoint) GetReactionTorque(invdt float64) float64 {
+	return 0
+}
+
+func (r *RopeJoint) GetCurrentLength() float64 {
+	return r.GetAnchor1().DistanceFromVector2(r.GetAnchor2())
+}
+
+func (r *RopeJoint) GetMaximumLength() float64 {
+	return r.maximumLength
+}
+
+func (r *RopeJoint) SetMaximumLength(maximumLength float64) {
+	if maximumLength < 0 {
+		panic("Maximum length must not be negative")
+	}
+	r.maximumLength = maximumLength
+	r.wakeBodies()
+}
@@ -0,0 +1,50 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests creating a rope joint with a nil anchor.
+ */
+func TestRopeJointNilAnchor(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	_, ground, box := createJointTestWorld()
+	NewRopeJoint(ground, box, nil, new(geometry.Vector2))
+}
+
+/**
+ * Tests that a slack rope does not constrain the bodies.
+ */
+func TestRopeJointSlack(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewRopeJoint(ground, box, ground.GetWorldCenter(), box.GetWorldCenter())
+	j.SetMaximumLength(10.0)
+	w.AddJoint(j)
+
+	w.StepN(30)
+	dyn4go.AssertEqualWithinError(t, 0.0, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).GetMagnitude(), 1.0e-9)
+	dyn4go.AssertTrue(t, j.GetCurrentLength() > 2.0)
+}
+
+/**
+ * Tests that a taut rope limits the maximum length.
+ */
+func TestRopeJointTaut(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	box.TranslateXY(-2.0, -1.0)
+	j := NewRopeJoint(ground, box, ground.GetWorldCenter(), box.GetWorldCenter())
+	j.SetMaximumLength(2.0)
+	w.AddJoint(j)
+
+	for i := 0; i < 120; i++ {
+		w.Step(DEFAULT_STEP_FREQUENCY)
+		dyn4go.AssertTrue(t, j.GetCurrentLength() < 2.0+0.01)
+	}
+	f := j.GetReactionForce(w.GetStep().GetInverseDeltaTime())
+	weight := box.GetMass().GetMass() * 9.8
+	dyn4go.AssertEqualWithinError(t, weight, f.GetMagnitude(), weight*0.02)
+}
@@ -0,0 +1,233 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+const (
+	BLOCK_SOLVER_MAXIMUM_CONDITION = 1000.0
+)
+
+type SequentialImpulses struct {
+	rv       geometry.Vector2
+	impulse  geometry.Vector2
+	velocity geometry.Vector2
+}
+
+var _ ContactConstraintSolver = new(SequentialImpulses)
+var _ ContactConstraintSolverCloner = new(SequentialImpulses)
+
+func (s *SequentialImpulses) Clone() ContactConstraintSolver {
+	return new(SequentialImpulses)
+}
+
+func (s *SequentialImpulses) Initialize(contactConstraints []*ContactConstraint, step *Step, settings *Settings) {
+	restitutionVelocity := settings.GetRestitutionVelocity()
+	for _, contactConstraint := range contactConstraints {
+		b1 := contactConstraint.body1
+		b2 := contactConstraint.body2
+		m1 := b1.GetMass()
+		m2 := b2.GetMass()
+		invM1 := m1.GetInverseMass()
+		invM2 := m2.GetInverseMass()
+		invI1 := m1.GetInverseInertia()
+		invI2 := m2.GetInverseInertia()
+		c1 := b1.GetWorldCenter()
+		c2 := b2.GetWorldCenter()
+		N := contactConstraint.normal
+		T := contactConstraint.tangent
+		for _, contact := range contactConstraint.contacts {
+			contact.r1 = c1.HereToVector2(contact.p)
+			contact.r2 = c2.HereToVector2(contact.p)
+
+			r1CrossN := contact.r1.CrossVector2(N)
+			r2CrossN := contact.r2.CrossVector2(N)
+			kn := invM1 + invM2 + invI1*r1CrossN*r1CrossN + invI2*r2CrossN*r2CrossN
+			contact.massN = 0
+			if kn > dyn4go.Epsilon {
+				contact.massN = 1 / kn
+			}
+
+			r1CrossT := contact.r1.CrossVector2(T)
+			r2CrossT := contact.r2.CrossVector2(T)
+			kt := invM1 + invM2 + invI1*r1CrossT*r1CrossT + invI2*r2CrossT*r2CrossT
+			contact.massT = 0
+			if kt > dyn4go.Epsilon {
+				contact.massT = 1 / kt
+			}
+
+			contact.vb = 0
+			if contact.depth < 0 {
+				contact.vb = contact.depth * step.GetInverseDeltaTime()
+				continue
+			}
+			rvn := N.DotVector2(s.relativeVelocity(contactConstraint, contact))
+			if rvn < -restitutionVelocity {
+				contact.vb = -contactConstraint.restitution * rvn
+			}
+		}
+
+		contactConstraint.K = nil
+		contactConstraint.invK = nil
+		if len(contactConstraint.contacts) == 2 {
+			contact1 := contactConstraint.contacts[0]
+			contact2 := contactConstraint.contacts[1]
+			rn1A := contact1.r1.CrossVector2(N)
+			rn1B := contact1.r2.CrossVector2(N)
+			rn2A := contact2.r1.CrossVector2(N)
+			rn2B := contact2.r2.CrossVector2(N)
+			k11 := invM1 + invM2 + invI1*rn1A*rn1A + invI2*rn1B*rn1B
+			k22 := invM1 + invM2 + invI1*rn2A*rn2A + invI2*rn2B*rn2B
+			k12 := invM1 + invM2 + invI1*rn1A*rn2A + invI2*rn1B*rn2B
+			if k11*k11 < BLOCK_SOLVER_MAXIMUM_CONDITION*(k11*k22-k12*k12) {
+				contactConstraint.K = geometry.NewMatrix22FromFloats(k11, k12, k12, k22)
+				contactConstraint.invK = contactConstraint.K.GetInverse()
+			}
+		}
+
+		for _, contact := range contactConstraint.contacts {
+			if settings.IsWarmStartingEnabled() {
+				contact.jn *= step.GetDeltaTimeRatio()
+				contact.jt *= step.GetDeltaTimeRatio()
+				J := N.Product(contact.jn).AddVector2(T.Product(contact.jt))
+				s.applyImpulse(contactConstraint, contact, J)
+			} else {
+				contact.jn = 0
+				contact.jt = 0
+			}
+		}
+	}
+}
+
+func (s *SequentialImpulses) SolveVelocityConstraints(contactConstraints []*ContactConstraint, step *Step, settings *Settings) {
+	for _, contactConstraint := range contactConstraints {
+		N := contactConstraint.normal
+		T := contactConstraint.tangent
+		for _, contact := range contactConstraint.contacts {
+			rvt := T.DotVector2(s.relativeVelocity(contactConstraint, contact))
+			jt := contact.massT * -rvt
+			maxJt := contactConstraint.friction * contact.jn
+			Jt0 := contact.jt
+			contact.jt = geometry.IntervalClamp(Jt0+jt, -maxJt, maxJt)
+			jt = contact.jt - Jt0
+			s.applyImpulse(contactConstraint, contact, s.product(&s.impulse, T, jt))
+		}
+
+		if contactConstraint.K == nil {
+			for _, contact := range contactConstraint.contacts {
+				rvn := N.DotVector2(s.relativeVelocity(contactConstraint, contact))
+				j := -contact.massN * (rvn - contact.vb)
+				j0 := contact.jn
+				contact.jn = math.Max(j0+j, 0)
+				j = contact.jn - j0
+				s.applyImpulse(contactConstraint, contact, s.product(&s.impulse, N, j))
+			}
+			continue
+		}
+
+		contact1 := contactConstraint.contacts[0]
+		contact2 := contactConstraint.contacts[1]
+		a := geometry.NewVector2FromXY(contact1.jn, contact2.jn)
+		vn1 := N.DotVector2(s.relativeVelocity(contactConstraint, contact1))
+		vn2 := N.DotVector2(s.relativeVelocity(contactConstraint, contact2))
+		b := geometry.NewVector2FromXY(vn1-contact1.vb, vn2-contact2.vb)
+		b.SubtractVector2(contactConstraint.K.ProductVector2(a))
+
+		x := contactConstraint.invK.ProductVector2(b).Negate()
+		if x.X < 0 || x.Y < 0 {
+			x.SetToXY(-contact1.massN*b.X, 0)
+			if x.X < 0 || contactConstraint.K.ProductVector2(x).AddVector2(b).Y < 0 {
+				x.SetToXY(0, -contact2.massN*b.Y)
+				if x.Y < 0 || contactConstraint.K.ProductVector2(x).AddVector2(b).X < 0 {
+					x.SetToXY(0, 0)
+					if b.X < 0 || b.Y < 0 {
+						continue
+					}
+				}
+			}
+		}
+		d := x.DifferenceVector2(a)
+		s.applyImpulse(contactConstraint, contact1, s.product(&s.impulse, N, d.X))
+		s.applyImpulse(contactConstraint, contact2, s.product(&s.impulse, N, d.Y))
+		contact1.jn = x.X
+		contact2.jn = x.Y
+	}
+}
+
+func (s *SequentialImpulses) SolvePositionConstraints(contactConstraints []*ContactConstraint, step *Step, settings *Settings) bool {
+	linearTolerance := settings.GetLinearTolerance()
+	maxLinearCorrection := settings.GetMaximumLinearCorrection()
+	baumgarte := settings.GetBaumgarte()
+	minSeparation := 0.0
+	for _, contactConstraint := range contactConstraints {
+		b1 := contactConstraint.body1
+		b2 := contactConstraint.body2
+		m1 := b1.GetMass()
+		m2 := b2.GetMass()
+		invM1 := m1.GetInverseMass()
+		invM2 := m2.GetInverseMass()
+		invI1 := m1.GetInverseInertia()
+		invI2 := m2.GetInverseInertia()
+		N := contactConstraint.normal
+		for _, contact := range contactConstraint.contacts {
+			c1 := b1.GetWorldCenter()
+			c2 := b2.GetWorldCenter()
+			p1 := b1.GetWorldPoint(contact.p1)
+			p2 := b2.GetWorldPoint(contact.p2)
+			r1 := c1.HereToVector2(p1)
+			r2 := c2.HereToVector2(p2)
+
+			separation := p2.HereToVector2(p1).DotVector2(N) - contact.depth
+			minSeparation = math.Min(minSeparation, separation)
+
+			C := geometry.IntervalClamp(baumgarte*(separation+linearTolerance), -maxLinearCorrection, 0)
+			rn1 := r1.CrossVector2(N)
+			rn2 := r2.CrossVector2(N)
+			K := invM1 + invM2 + invI1*rn1*rn1 + invI2*rn2*rn2
+			impulse := 0.0
+			if K > 0 {
+				impulse = -C / K
+			}
+			J := N.Product(impulse)
+
+			if b1.IsDynamic() {
+				b1.TranslateVector2(J.Product(invM1))
+				b1.RotateAboutVector2(invI1*r1.CrossVector2(J), c1)
+			}
+			if b2.IsDynamic() {
+				b2.TranslateVector2(J.Product(-invM2))
+				b2.RotateAboutVector2(-invI2*r2.CrossVector2(J), c2)
+			}
+		}
+	}
+	return minSeparation >= -3*linearTolerance
+}
+
+func (s *SequentialImpulses) relativeVelocity(contactConstraint *ContactConstraint, contact *Contact) *geometry.Vector2 {
+	b1 := contactConstraint.body1
+	b2 := contactConstraint.body2
+	rv := &s.rv
+	rv.X = -contact.r1.Y*b1.angularVelocity + b1.velocity.X
+	rv.Y = contact.r1.X*b1.angularVelocity + b1.velocity.Y
+	rv.X -= -contact.r2.Y*b2.angularVelocity + b2.velocity.X
+	rv.Y -= contact.r2.X*b2.angularVelocity + b2.velocity.Y
+	return rv
+}
+
+func (s *SequentialImpulses) applyImpulse(contactConstraint *ContactConstraint, contact *Contact, J *geometry.Vector2) {
+	b1 := contactConstraint.body1
+	b2 := contactConstraint.body2
+	m1 := b1.GetMass()
+	m2 := b2.GetMass()
+	b1.addVelocity(s.product(&s.velocity, J, m1.GetInverseMass()), m1.GetInverseInertia()*contact.r1.CrossVector2(J))
+	b2.subtractVelocity(s.product(&s.velocity, J, m2.GetInverseMass()), m2.GetInverseInertia()*contact.r2.CrossVector2(J))
+}
+
+func (s *SequentialImpulses) product(result, v *geometry.Vector2, scalar float64) *geometry.Vector2 {
+	result.X = v.X * scalar
+	result.Y = v.Y * scalar
+	return result
+}
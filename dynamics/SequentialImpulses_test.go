@@ -0,0 +1,118 @@
+package dynamics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func createSolverTestWorld() (*World, *Body) {
+	w := NewWorld()
+	floor := createWorldTestBody(geometry.CreateRectangle(10.0, 1.0), geometry.INFINITE)
+	w.AddBody(floor)
+	return w, floor
+}
+
+/**
+ * Tests the default coefficient mixer.
+ */
+func TestDefaultCoefficientMixer(t *testing.T) {
+	dyn4go.AssertEqualWithinError(t, 0.4, DEFAULT_MIXER.MixFriction(0.2, 0.8), 1.0e-9)
+	dyn4go.AssertEqual(t, 0.8, DEFAULT_MIXER.MixRestitution(0.2, 0.8))
+}
+
+/**
+ * Tests that a box resting on the floor neither sinks nor drifts.
+ */
+func TestSequentialImpulsesResting(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.TranslateXY(0.0, 1.0)
+	w.AddBody(box)
+
+	w.StepN(120)
+	dyn4go.AssertEqualWithinError(t, 1.0, box.GetTransform().Y, DEFAULT_LINEAR_TOLERANCE*2)
+	dyn4go.AssertTrue(t, math.Abs(box.GetTransform().X) < 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, 0.0, box.GetVelocity().GetMagnitude(), 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, 0.0, box.GetTransform().GetRotation(), 1.0e-6)
+}
+
+/**
+ * Tests that a stack of boxes remains standing.
+ */
+func TestSequentialImpulsesStack(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	boxes := make([]*Body, 5)
+	for i := range boxes {
+		boxes[i] = createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+		boxes[i].TranslateXY(0.0, 1.0+float64(i))
+		w.AddBody(boxes[i])
+	}
+
+	w.StepN(300)
+	for i, box := range boxes {
+		dyn4go.AssertEqualWithinError(t, 1.0+float64(i), box.GetTransform().Y, 0.05)
+		dyn4go.AssertEqualWithinError(t, 0.0, box.GetTransform().X, 1.0e-3)
+	}
+}
+
+/**
+ * Tests that accumulated impulses are carried over to the next step.
+ */
+func TestSequentialImpulsesWarmStart(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.TranslateXY(0.0, 1.0)
+	w.AddBody(box)
+
+	w.StepN(10)
+	contacts := w.GetContactConstraints()[0].GetContacts()
+	dyn4go.AssertEqual(t, 2, len(contacts))
+	for _, c := range contacts {
+		dyn4go.AssertTrue(t, c.GetNormalImpulse() > 0)
+	}
+
+	w.GetSettings().SetWarmStartingEnabled(false)
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	for _, c := range w.GetContactConstraints()[0].GetContacts() {
+		dyn4go.AssertEqual(t, 0.0, c.GetNormalImpulse())
+	}
+}
+
+/**
+ * Tests that the mixed restitution makes a falling box bounce.
+ */
+func TestSequentialImpulsesRestitution(t *testing.T) {
+	w, _ := createSolverTestWorld()
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.GetBodyFixture(0).SetRestitution(1.0)
+	box.TranslateXY(0.0, 3.0)
+	w.AddBody(box)
+
+	bounced := false
+	for i := 0; i < 120 && !bounced; i++ {
+		w.Step(DEFAULT_STEP_FREQUENCY)
+		bounced = box.GetVelocity().Y > 4.0
+	}
+	dyn4go.AssertTrue(t, bounced)
+}
+
+/**
+ * Tests that friction brings a sliding box to rest.
+ */
+func TestSequentialImpulsesFriction(t *testing.T) {
+	w, floor := createSolverTestWorld()
+	floor.GetBodyFixture(0).SetFriction(0.5)
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.GetBodyFixture(0).SetFriction(0.5)
+	box.TranslateXY(-3.0, 1.0)
+	box.SetVelocity(geometry.NewVector2FromXY(2.0, 0.0))
+	w.AddBody(box)
+
+	w.StepN(120)
+	dyn4go.AssertTrue(t, math.Abs(box.GetVelocity().X) < 1.0e-3)
+	x := box.GetTransform().X
+	dyn4go.AssertTrue(t, x > -3.0 && x < -2.0)
+}
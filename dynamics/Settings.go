@@ -0,0 +1,335 @@
+package dynamics
+
+import (
+	"math"
+)
+
+const (
+	DEFAULT_STEP_FREQUENCY      = 1.0 / 60.0
+	DEFAULT_MAXIMUM_TRANSLATION = 2.0
+	DEFAULT_MAXIMUM_ROTATION    = 0.5 * math.Pi
+
+	DEFAULT_VELOCITY_CONSTRAINT_SOLVER_ITERATIONS = 10
+	DEFAULT_POSITION_CONSTRAINT_SOLVER_ITERATIONS = 10
+	DEFAULT_WARM_START_DISTANCE                   = 1.0e-2
+	DEFAULT_RESTITUTION_VELOCITY                  = 1.0
+	DEFAULT_LINEAR_TOLERANCE                      = 0.005
+	DEFAULT_ANGULAR_TOLERANCE                     = 2.0 * math.Pi / 180.0
+	DEFAULT_MAXIMUM_LINEAR_CORRECTION             = 0.2
+	DEFAULT_MAXIMUM_ANGULAR_CORRECTION            = 30.0 * math.Pi / 180.0
+	DEFAULT_BAUMGARTE                             = 0.2
+
+	DEFAULT_SLEEP_LINEAR_VELOCITY  = 0.01
+	DEFAULT_SLEEP_ANGULAR_VELOCITY = 2.0 * math.Pi / 180.0
+	DEFAULT_SLEEP_TIME             = 0.5
+
+	DEFAULT_ISLAND_SOLVER_WORKER_COUNT = 1
+)
+
+const (
+	CONTINUOUS_DETECTION_MODE_NONE = iota
+	CONTINUOUS_DETECTION_MODE_BULLETS_ONLY
+	CONTINUOUS_DETECTION_MODE_ALL
+	CONTINUOUS_DETECTION_MODE_SPECULATIVE
+)
+
+type Settings struct {
+	stepFrequency      float64
+	maximumTranslation float64
+	maximumRotation    float64
+
+	continuousDetectionMode int
+
+	autoSleepingEnabled         bool
+	sleepLinearVelocity         float64
+	sleepLinearVelocitySquared  float64
+	sleepAngularVelocity        float64
+	sleepAngularVelocitySquared float64
+	sleepTime                   float64
+
+	velocityConstraintSolverIterations int
+	positionConstraintSolverIterations int
+	warmStartingEnabled                bool
+	warmStartDistance                  float64
+	warmStartDistanceSquared           float64
+	restitutionVelocity                float64
+	restitutionVelocitySquared         float64
+	linearTolerance                    float64
+	linearToleranceSquared             float64
+	angularTolerance                   float64
+	angularToleranceSquared            float64
+	maximumLinearCorrection            float64
+	maximumLinearCorrectionSquared     float64
+	maximumAngularCorrection           float64
+	maximumAngularCorrectionSquared    float64
+	baumgarte                          float64
+
+	islandSolverWorkerCount int
+}
+
+func NewSettings() *Settings {
+	s := new(Settings)
+	s.stepFrequency = DEFAULT_STEP_FREQUENCY
+	s.maximumTranslation = DEFAULT_MAXIMUM_TRANSLATION
+	s.maximumRotation = DEFAULT_MAXIMUM_ROTATION
+	s.continuousDetectionMode = CONTINUOUS_DETECTION_MODE_ALL
+	s.autoSleepingEnabled = true
+	s.SetSleepLinearVelocity(DEFAULT_SLEEP_LINEAR_VELOCITY)
+	s.SetSleepAngularVelocity(DEFAULT_SLEEP_ANGULAR_VELOCITY)
+	s.sleepTime = DEFAULT_SLEEP_TIME
+	s.velocityConstraintSolverIterations = DEFAULT_VELOCITY_CONSTRAINT_SOLVER_ITERATIONS
+	s.positionConstraintSolverIterations = DEFAULT_POSITION_CONSTRAINT_SOLVER_ITERATIONS
+	s.warmStartingEnabled = true
+	s.SetWarmStartDistance(DEFAULT_WARM_START_DISTANCE)
+	s.SetRestitutionVelocity(DEFAULT_RESTITUTION_VELOCITY)
+	s.SetLinearTolerance(DEFAULT_LINEAR_TOLERANCE)
+	s.SetAngularTolerance(DEFAULT_ANGULAR_TOLERANCE)
+	s.SetMaximumLinearCorrection(DEFAULT_MAXIMUM_LINEAR_CORRECTION)
+	s.SetMaximumAngularCorrection(DEFAULT_MAXIMUM_ANGULAR_CORRECTION)
+	s.baumgarte = DEFAULT_BAUMGARTE
+	s.islandSolverWorkerCount = DEFAULT_ISLAND_SOLVER_WORKER_COUNT
+	return s
+}
+
+func (s *Settings) GetStepFrequency() float64 {
+	return s.stepFrequency
+}
+
+func (s *Settings) SetStepFrequency(stepFrequency float64) {
+	if stepFrequency <= 0 {
+		panic("Step frequency must be strictly positive")
+	}
+	s.stepFrequency = stepFrequency
+}
+
+func (s *Settings) GetMaximumTranslation() float64 {
+	return s.maximumTranslation
+}
+
+func (s *Settings) SetMaximumTranslation(maximumTranslation float64) {
+	if maximumTranslation < 0 {
+		panic("Maximum translation must not be negative")
+	}
+	s.maximumTranslation = maximumTranslation
+}
+
+func (s *Settings) GetMaximumRotation() float64 {
+	return s.maximumRotation
+}
+
+func (s *Settings) SetMaximumRotation(maximumRotation float64) {
+	if maximumRotation < 0 {
+		panic("Maximum rotation must not be negative")
+	}
+	s.maximumRotation = maximumRotation
+}
+
+func (s *Settings) GetContinuousDetectionMode() int {
+	return s.continuousDetectionMode
+}
+
+func (s *Settings) SetContinuousDetectionMode(mode int) {
+	if mode < CONTINUOUS_DETECTION_MODE_NONE || mode > CONTINUOUS_DETECTION_MODE_SPECULATIVE {
+		panic("Invalid continuous detection mode")
+	}
+	s.continuousDetectionMode = mode
+}
+
+func (s *Settings) IsAutoSleepingEnabled() bool {
+	return s.autoSleepingEnabled
+}
+
+func (s *Settings) SetAutoSleepingEnabled(flag bool) {
+	s.autoSleepingEnabled = flag
+}
+
+func (s *Settings) GetSleepLinearVelocity() float64 {
+	return s.sleepLinearVelocity
+}
+
+func (s *Settings) GetSleepLinearVelocitySquared() float64 {
+	return s.sleepLinearVelocitySquared
+}
+
+func (s *Settings) SetSleepLinearVelocity(sleepLinearVelocity float64) {
+	if sleepLinearVelocity < 0 {
+		panic("Sleep linear velocity must not be negative")
+	}
+	s.sleepLinearVelocity = sleepLinearVelocity
+	s.sleepLinearVelocitySquared = sleepLinearVelocity * sleepLinearVelocity
+}
+
+func (s *Settings) GetSleepAngularVelocity() float64 {
+	return s.sleepAngularVelocity
+}
+
+func (s *Settings) GetSleepAngularVelocitySquared() float64 {
+	return s.sleepAngularVelocitySquared
+}
+
+func (s *Settings) SetSleepAngularVelocity(sleepAngularVelocity float64) {
+	if sleepAngularVelocity < 0 {
+		panic("Sleep angular velocity must not be negative")
+	}
+	s.sleepAngularVelocity = sleepAngularVelocity
+	s.sleepAngularVelocitySquared = sleepAngularVelocity * sleepAngularVelocity
+}
+
+func (s *Settings) GetSleepTime() float64 {
+	return s.sleepTime
+}
+
+func (s *Settings) SetSleepTime(sleepTime float64) {
+	if sleepTime < 0 {
+		panic("Sleep time must not be negative")
+	}
+	s.sleepTime = sleepTime
+}
+
+func (s *Settings) GetVelocityConstraintSolverIterations() int {
+	return s.velocityConstraintSolverIterations
+}
+
+func (s *Settings) SetVelocityConstraintSolverIterations(iterations int) {
+	if iterations < 5 {
+		panic("Velocity constraint solver iterations must be at least 5")
+	}
+	s.velocityConstraintSolverIterations = iterations
+}
+
+func (s *Settings) GetPositionConstraintSolverIterations() int {
+	return s.positionConstraintSolverIterations
+}
+
+func (s *Settings) SetPositionConstraintSolverIterations(iterations int) {
+	if iterations < 5 {
+		panic("Position constraint solver iterations must be at least 5")
+	}
+	s.positionConstraintSolverIterations = iterations
+}
+
+func (s *Settings) IsWarmStartingEnabled() bool {
+	return s.warmStartingEnabled
+}
+
+func (s *Settings) SetWarmStartingEnabled(flag bool) {
+	s.warmStartingEnabled = flag
+}
+
+func (s *Settings) GetWarmStartDistance() float64 {
+	return s.warmStartDistance
+}
+
+func (s *Settings) GetWarmStartDistanceSquared() float64 {
+	return s.warmStartDistanceSquared
+}
+
+func (s *Settings) SetWarmStartDistance(warmStartDistance float64) {
+	if warmStartDistance < 0 {
+		panic("Warm start distance must not be negative")
+	}
+	s.warmStartDistance = warmStartDistance
+	s.warmStartDistanceSquared = warmStartDistance * warmStartDistance
+}
+
+func (s *Settings) GetRestitutionVelocity() float64 {
+	return s.restitutionVelocity
+}
+
+func (s *Settings) GetRestitutionVelocitySquared() float64 {
+	return s.restitutionVelocitySquared
+}
+
+func (s *Settings) SetRestitutionVelocity(restitutionVelocity float64) {
+	if restitutionVelocity < 0 {
+		panic("Restitution velocity must not be negative")
+	}
+	s.restitutionVelocity = restitutionVelocity
+	s.restitutionVelocitySquared = restitutionVelocity * restitutionVelocity
+}
+
+func (s *Settings) GetLinearTolerance() float64 {
+	return s.linearTolerance
+}
+
+func (s *Settings) GetLinearToleranceSquared() float64 {
+	return s.linearToleranceSquared
+}
+
+func (s *Settings) SetLinearTolerance(linearTolerance float64) {
+	if linearTolerance < 0 {
+		panic("Linear tolerance must not be negative")
+	}
+	s.linearTolerance = linearTolerance
+	s.linearToleranceSquared = linearTolerance * linearTolerance
+}
+
+func (s *Settings) GetAngularTolerance() float64 {
+	return s.angularTolerance
+}
+
+func (s *Settings) GetAngularToleranceSquared() float64 {
+	return s.angularToleranceSquared
+}
+
+func (s *Settings) SetAngularTolerance(angularTolerance float64) {
+	if angularTolerance < 0 {
+		panic("Angular tolerance must not be negative")
+	}
+	s.angularTolerance = angularTolerance
+	s.angularToleranceSquared = angularTolerance * angularTolerance
+}
+
+func (s *Settings) GetMaximumLinearCorrection() float64 {
+	return s.maximumLinearCorrection
+}
+
+func (s *Settings) GetMaximumLinearCorrectionSquared() float64 {
+	return s.maximumLinearCorrectionSquared
+}
+
+func (s *Settings) SetMaximumLinearCorrection(maximumLinearCorrection float64) {
+	if maximumLinearCorrection < 0 {
+		panic("Maximum linear correction must not be negative")
+	}
+	s.maximumLinearCorrection = maximumLinearCorrection
+	s.maximumLinearCorrectionSquared = maximumLinearCorrection * maximumLinearCorrection
+}
+
+func (s *Settings) GetMaximumAngularCorrection() float64 {
+	return s.maximumAngularCorrection
+}
+
+func (s *Settings) GetMaximumAngularCorrectionSquared() float64 {
+	return s.maximumAngularCorrectionSquared
+}
+
+func (s *Settings) SetMaximumAngularCorrection(maximumAngularCorrection float64) {
+	if maximumAngularCorrection < 0 {
+		panic("Maximum angular correction must not be negative")
+	}
+	s.maximumAngularCorrection = maximumAngularCorrection
+	s.maximumAngularCorrectionSquared = maximumAngularCorrection * maximumAngularCorrection
+}
+
+func (s *Settings) GetBaumgarte() float64 {
+	return s.baumgarte
+}
+
+func (s *Settings) SetBaumgarte(baumgarte float64) {
+	if baumgarte < 0 {
+		panic("Baumgarte must not be negative")
+	}
+	s.baumgarte = baumgarte
+}
+
+func (s *Settings) GetIslandSolverWorkerCount() int {
+	return s.islandSolverWorkerCount
+}
+
+func (s *Settings) SetIslandSolverWorkerCount(count int) {
+	if count < 1 {
+		panic("Island solver worker count must be at least 1")
+	}
+	s.islandSolverWorkerCount = count
+}
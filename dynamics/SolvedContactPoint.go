@@ -0,0 +1,23 @@
+package dynamics
+
+type SolvedContactPoint struct {
+	ContactPoint
+	normalImpulse  float64
+	tangentImpulse float64
+}
+
+func newSolvedContactPoint(contactConstraint *ContactConstraint, contact *Contact) *SolvedContactPoint {
+	s := new(SolvedContactPoint)
+	s.ContactPoint = *newContactPoint(contact.state, contactConstraint, contact)
+	s.normalImpulse = contact.jn
+	s.tangentImpulse = contact.jt
+	return s
+}
+
+func (s *SolvedContactPoint) GetNormalImpulse() float64 {
+	return s.normalImpulse
+}
+
+func (s *SolvedContactPoint) GetTangentImpulse() float64 {
+	return s.tangentImpulse
+}
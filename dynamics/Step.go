@@ -0,0 +1,46 @@
+package dynamics
+
+type Step struct {
+	dt, invdt, dt0, invdt0, dtRatio float64
+}
+
+func NewStep(dt float64) *Step {
+	if dt <= 0 {
+		panic("Step time must be strictly positive")
+	}
+	s := new(Step)
+	s.dt = dt
+	s.invdt = 1 / dt
+	s.dt0 = s.dt
+	s.invdt0 = s.invdt
+	s.dtRatio = 1
+	return s
+}
+
+func (s *Step) update(dt float64) {
+	s.dt0 = s.dt
+	s.invdt0 = s.invdt
+	s.dt = dt
+	s.invdt = 1 / dt
+	s.dtRatio = s.invdt0 * dt
+}
+
+func (s *Step) GetDeltaTime() float64 {
+	return s.dt
+}
+
+func (s *Step) GetInverseDeltaTime() float64 {
+	return s.invdt
+}
+
+func (s *Step) GetPreviousDeltaTime() float64 {
+	return s.dt0
+}
+
+func (s *Step) GetPreviousInverseDeltaTime() float64 {
+	return s.invdt0
+}
+
+func (s *Step) GetDeltaTimeRatio() float64 {
+	return s.dtRatio
+}
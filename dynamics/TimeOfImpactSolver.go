@@ -0,0 +1,46 @@
+package dynamics
+
+import (
+	"github.com/LSFN/dyn4go/collision/continuous"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type TimeOfImpactSolver struct{}
+
+func (s *TimeOfImpactSolver) Solve(body1, body2 *Body, toi *continuous.TimeOfImpact, settings *Settings) {
+	linearTolerance := settings.GetLinearTolerance()
+	maxLinearCorrection := settings.GetMaximumLinearCorrection()
+
+	c1 := body1.GetWorldCenter()
+	c2 := body2.GetWorldCenter()
+	m1 := body1.GetMass()
+	m2 := body2.GetMass()
+	mass1 := m1.GetMass()
+	mass2 := m2.GetMass()
+	invM1 := mass1 * m1.GetInverseMass()
+	invI1 := mass1 * m1.GetInverseInertia()
+	invM2 := 0.0
+	invI2 := 0.0
+	if body2.IsDynamic() {
+		invM2 = mass2 * m2.GetInverseMass()
+		invI2 = mass2 * m2.GetInverseInertia()
+	}
+
+	separation := toi.GetSeparation()
+	n := separation.GetNormal()
+	r1 := c1.HereToVector2(separation.GetPoint1())
+	r2 := c2.HereToVector2(separation.GetPoint2())
+
+	C := geometry.IntervalClamp(separation.GetDistance()-linearTolerance, -maxLinearCorrection, 0)
+	rn1 := r1.CrossVector2(n)
+	rn2 := r2.CrossVector2(n)
+	K := invM1 + invM2 + invI1*rn1*rn1 + invI2*rn2*rn2
+	impulse := 0.0
+	if K > 0 {
+		impulse = -C / K
+	}
+	J := n.Product(impulse)
+
+	body1.correctPosition(J.Product(invM1), invI1*r1.CrossVector2(J))
+	body2.correctPosition(J.Product(-invM2), -invI2*r2.CrossVector2(J))
+}
@@ -0,0 +1,216 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type WeldJoint struct {
+	Joint
+	localAnchor1, localAnchor2 *geometry.Vector2
+	referenceAngle             float64
+	frequency                  float64
+	dampingRatio               float64
+	K                          *geometry.Matrix33
+	axialMass                  float64
+	gamma                      float64
+	bias                       float64
+	impulse                    *geometry.Vector3
+}
+
+var _ Jointer = new(WeldJoint)
+
+func NewWeldJoint(body1, body2 *Body, anchor *geometry.Vector2) *WeldJoint {
+	if body1 == body2 {
+		panic("Cannot create a weld joint between the same body")
+	}
+	if anchor == nil {
+		panic("Cannot create a weld joint with a nil anchor")
+	}
+	w := new(WeldJoint)
+	InitJoint(&w.Joint, body1, body2, false)
+	w.localAnchor1 = body1.GetLocalPoint(anchor)
+	w.localAnchor2 = body2.GetLocalPoint(anchor)
+	w.referenceAngle = body1.transform.GetRotation() - body2.transform.GetRotation()
+	w.impulse = new(geometry.Vector3)
+	return w
+}
+
+func (w *WeldJoint) InitializeConstraints(step *Step, settings *Settings) {
+	invI1 := w.body1.mass.GetInverseInertia()
+	invI2 := w.body2.mass.GetInverseInertia()
+	dt := step.GetDeltaTime()
+
+	r1 := w.getR1(w.localAnchor1)
+	r2 := w.getR2(w.localAnchor2)
+	w.K = w.getK(r1, r2)
+
+	w.axialMass = 0
+	w.gamma = 0
+	w.bias = 0
+	if w.frequency > 0 {
+		invI := invI1 + invI2
+		m := 0.0
+		if invI > 0 {
+			m = 1 / invI
+		}
+		C := getRelativeRotation(w.body1, w.body2, w.referenceAngle)
+		omega := geometry.TWO_PI * w.frequency
+		dc := 2 * m * w.dampingRatio * omega
+		k := m * omega * omega
+		w.gamma = dt * (dc + dt*k)
+		if w.gamma != 0 {
+			w.gamma = 1 / w.gamma
+		}
+		w.bias = -C * dt * k * w.gamma
+		invI += w.gamma
+		if invI != 0 {
+			w.axialMass = 1 / invI
+		}
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		w.impulse.Multiply(step.GetDeltaTimeRatio())
+	} else {
+		w.impulse.Zero()
+	}
+	P := geometry.NewVector2FromXY(w.impulse.X, w.impulse.Y)
+	w.applyImpulse(P, r1.CrossVector2(P)+w.impulse.Z, r2.CrossVector2(P)+w.impulse.Z)
+}
+
+func (w *WeldJoint) getK(r1, r2 *geometry.Vector2) *geometry.Matrix33 {
+	invM1 := w.body1.mass.GetInverseMass()
+	invM2 := w.body2.mass.GetInverseMass()
+	invI1 := w.body1.mass.GetInverseInertia()
+	invI2 := w.body2.mass.GetInverseInertia()
+
+	k11 := invM1 + invM2 + r1.Y*r1.Y*invI1 + r2.Y*r2.Y*invI2
+	k12 := -r1.Y*r1.X*invI1 - r2.Y*r2.X*invI2
+	k13 := -r1.Y*invI1 - r2.Y*invI2
+	k22 := invM1 + invM2 + r1.X*r1.X*invI1 + r2.X*r2.X*invI2
+	k23 := r1.X*invI1 + r2.X*invI2
+	k33 := invI1 + invI2
+	return geometry.NewMatrix33FromFloats(k11, k12, k13, k12, k22, k23, k13, k23, k33)
+}
+
+func (w *WeldJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	invI1 := w.body1.mass.GetInverseInertia()
+	invI2 := w.body2.mass.GetInverseInertia()
+
+	r1 := w.getR1(w.localAnchor1)
+	r2 := w.getR2(w.localAnchor2)
+
+	if w.frequency > 0 {
+		Cdot2 := w.body2.angularVelocity - w.body1.angularVelocity
+		impulse2 := -w.axialMass * (Cdot2 + w.bias + w.gamma*w.impulse.Z)
+		w.impulse.Z += impulse2
+		w.body1.addAngularVelocity(-invI1 * impulse2)
+		w.body2.addAngularVelocity(invI2 * impulse2)
+
+		v1 := r1.CrossZ(w.body1.angularVelocity).AddVector2(w.body1.velocity)
+		v2 := r2.CrossZ(w.body2.angularVelocity).AddVector2(w.body2.velocity)
+		P := w.K.Solve22(v1.HereToVector2(v2)).Negate()
+		w.impulse.X += P.X
+		w.impulse.Y += P.Y
+		w.applyImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+	} else {
+		v1 := r1.CrossZ(w.body1.angularVelocity).AddVector2(w.body1.velocity)
+		v2 := r2.CrossZ(w.body2.angularVelocity).AddVector2(w.body2.velocity)
+		Cdot1 := v1.HereToVector2(v2)
+		Cdot2 := w.body2.angularVelocity - w.body1.angularVelocity
+		impulse := w.solve(geometry.NewVector3FromFloats(Cdot1.X, Cdot1.Y, Cdot2)).Negate()
+		w.impulse.AddVector3(impulse)
+		P := geometry.NewVector2FromXY(impulse.X, impulse.Y)
+		w.applyImpulse(P, r1.CrossVector2(P)+impulse.Z, r2.CrossVector2(P)+impulse.Z)
+	}
+}
+
+func (w *WeldJoint) solve(v *geometry.Vector3) *geometry.Vector3 {
+	invI1 := w.body1.mass.GetInverseInertia()
+	invI2 := w.body2.mass.GetInverseInertia()
+	if invI1+invI2 > 0 {
+		return w.K.Solve33(v)
+	}
+	v2 := w.K.Solve22(geometry.NewVector2FromXY(v.X, v.Y))
+	return geometry.NewVector3FromFloats(v2.X, v2.Y, 0)
+}
+
+func (w *WeldJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	linearTolerance := settings.GetLinearTolerance()
+	angularTolerance := settings.GetAngularTolerance()
+
+	r1 := w.getR1(w.localAnchor1)
+	r2 := w.getR2(w.localAnchor2)
+	w.K = w.getK(r1, r2)
+
+	C1 := w.body1.GetWorldCenter().AddVector2(r1).HereToVector2(w.body2.GetWorldCenter().AddVector2(r2))
+	linearError := C1.GetMagnitude()
+	angularError := 0.0
+
+	if w.frequency > 0 {
+		P := w.K.Solve22(C1).Negate()
+		w.applyPositionImpulse(P, r1.CrossVector2(P), r2.CrossVector2(P))
+	} else {
+		C2 := getRelativeRotation(w.body1, w.body2, w.referenceAngle)
+		angularError = math.Abs(C2)
+		impulse := w.solve(geometry.NewVector3FromFloats(C1.X, C1.Y, -C2)).Negate()
+		P := geometry.NewVector2FromXY(impulse.X, impulse.Y)
+		w.applyPositionImpulse(P, r1.CrossVector2(P)+impulse.Z, r2.CrossVector2(P)+impulse.Z)
+	}
+
+	return linearError <= linearTolerance && angularError <= angularTolerance
+}
+
+func (w *WeldJoint) GetAnchor1() *geometry.Vector2 {
+	return w.body1.GetWorldPoint(w.localAnchor1)
+}
+
+func (w *WeldJoint) GetAnchor2() *geometry.Vector2 {
+	return w.body2.GetWorldPoint(w.localAnchor2)
+}
+
+func (w *WeldJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return geometry.NewVector2FromXY(w.impulse.X*invdt, w.impulse.Y*invdt)
+}
+
+func (w *WeldJoint) GetReactionTorque(invdt float64) float64 {
+	return w.impulse.Z * invdt
+}
+
+func (w *WeldJoint) IsSpringEnabled() bool {
+	return w.frequency > 0
+}
+
+func (w *WeldJoint) GetFrequency() float64 {
+	return w.frequency
+}
+
+func (w *WeldJoint) SetFrequency(frequency float64) {
+	if frequency < 0 {
+		panic("Frequency must not be negative")
+	}
+	w.frequency = frequency
+	w.wakeBodies()
+}
+
+func (w *WeldJoint) GetDampingRatio() float64 {
+	return w.dampingRatio
+}
+
+func (w *WeldJoint) SetDampingRatio(dampingRatio float64) {
+	if dampingRatio < 0 || dampingRatio > 1 {
+		panic("Damping ratio must be between 0 and 1 inclusive")
+	}
+	w.dampingRatio = dampingRatio
+	w.wakeBodies()
+}
+
+func (w *WeldJoint) GetReferenceAngle() float64 {
+	return w.referenceAngle
+}
+
+func (w *WeldJoint) SetReferenceAngle(referenceAngle float64) {
+	w.referenceAngle = referenceAngle
+	w.wakeBodies()
+}
@@ -0,0 +1,106 @@
+package dynamics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests creating a weld joint between the same body.
+ */
+func TestWeldJointSameBody(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	b := NewBody()
+	NewWeldJoint(b, b, new(geometry.Vector2))
+}
+
+/**
+ * Tests that a rigid weld holds a cantilevered body in place.
+ */
+func TestWeldJointRigid(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewWeldJoint(ground, box, geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertFalse(t, j.IsSpringEnabled())
+	w.AddJoint(j)
+
+	for i := 0; i < 120; i++ {
+		w.Step(DEFAULT_STEP_FREQUENCY)
+		dyn4go.AssertEqualWithinError(t, 0.0, j.GetAnchor1().DistanceFromVector2(j.GetAnchor2()), 0.01)
+		dyn4go.AssertEqualWithinError(t, 0.0, box.GetTransform().GetRotation(), 0.01)
+	}
+
+	invdt := w.GetStep().GetInverseDeltaTime()
+	weight := box.GetMass().GetMass() * 9.8
+	dyn4go.AssertEqualWithinError(t, weight, j.GetReactionForce(invdt).GetMagnitude(), weight*0.01)
+	dyn4go.AssertTrue(t, math.Abs(j.GetReactionTorque(invdt)-weight) < weight*0.01)
+}
+
+/**
+ * Tests that a soft weld allows the body to sag.
+ */
+func TestWeldJointSoft(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewWeldJoint(ground, box, geometry.NewVector2FromXY(1.0, 0.0))
+	j.SetFrequency(1.0)
+	j.SetDampingRatio(0.5)
+	dyn4go.AssertTrue(t, j.IsSpringEnabled())
+	w.AddJoint(j)
+
+	w.StepN(60)
+	dyn4go.AssertTrue(t, box.GetTransform().GetRotation() < -0.05)
+	dyn4go.AssertEqualWithinError(t, 0.0, j.GetAnchor1().DistanceFromVector2(j.GetAnchor2()), 0.01)
+}
+
+/**
+ * Tests that accumulated impulses are not applied when warm starting is disabled.
+ */
+func TestWeldJointWarmStartDisabled(t *testing.T) {
+	w, ground, box := createJointTestWorld()
+	j := NewWeldJoint(ground, box, geometry.NewVector2FromXY(1.0, 0.0))
+	w.AddJoint(j)
+	w.StepN(30)
+	invdt := w.GetStep().GetInverseDeltaTime()
+	dyn4go.AssertTrue(t, j.GetReactionForce(invdt).GetMagnitude() > 0)
+	dyn4go.AssertTrue(t, j.GetReactionTorque(invdt) != 0)
+
+	settings := w.GetSettings()
+	settings.SetWarmStartingEnabled(false)
+	v := geometry.NewVector2FromVector2(box.GetVelocity())
+	av := box.GetAngularVelocity()
+	j.InitializeConstraints(w.GetStep(), settings)
+	dyn4go.AssertTrue(t, j.GetReactionForce(invdt).IsZero())
+	dyn4go.AssertEqual(t, 0.0, j.GetReactionTorque(invdt))
+	dyn4go.AssertTrue(t, v.EqualsVector2(box.GetVelocity()))
+	dyn4go.AssertEqual(t, av, box.GetAngularVelocity())
+}
+
+/**
+ * Tests that the reference angle holds the same relative rotation as on the
+ * revolute joint.
+ */
+func TestWeldJointReferenceAngle(t *testing.T) {
+	for _, frequency := range []float64{0.0, 5.0} {
+		w, ground, box := createJointTestWorld()
+		w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+		j := NewWeldJoint(ground, box, geometry.NewVector2FromXY(1.0, 0.0))
+		j.SetFrequency(frequency)
+		j.SetDampingRatio(1.0)
+		j.SetReferenceAngle(0.5)
+		w.AddJoint(j)
+
+		rw, rground, rbox := createJointTestWorld()
+		rw.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+		r := NewRevoluteJoint(rground, rbox, geometry.NewVector2FromXY(1.0, 0.0))
+		r.SetReferenceAngle(0.5)
+		r.SetLimitEnabled(true)
+		rw.AddJoint(r)
+
+		w.StepN(240)
+		rw.StepN(240)
+		dyn4go.AssertTrue(t, math.Abs(box.GetTransform().GetRotation()+0.5) < 0.01)
+		dyn4go.AssertTrue(t, math.Abs(rbox.GetTransform().GetRotation()-box.GetTransform().GetRotation()) < 0.01)
+	}
+}
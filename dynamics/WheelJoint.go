@@ -0,0 +1,260 @@
+package dynamics
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type WheelJoint struct {
+	Joint
+	localAnchor1, localAnchor2 *geometry.Vector2
+	xAxis, yAxis               *geometry.Vector2
+	frequency                  float64
+	dampingRatio               float64
+	motorEnabled               bool
+	motorSpeed                 float64
+	maximumMotorTorque         float64
+	invK                       float64
+	springMass                 float64
+	motorMass                  float64
+	bias                       float64
+	gamma                      float64
+	impulse                    float64
+	springImpulse              float64
+	motorImpulse               float64
+	axis, perp                 *geometry.Vector2
+	sAx, sBx, sAy, sBy         float64
+}
+
+var _ Jointer = new(WheelJoint)
+
+func NewWheelJoint(body1, body2 *Body, anchor, axis *geometry.Vector2) *WheelJoint {
+	if body1 == body2 {
+		panic("Cannot create a wheel joint between the same body")
+	}
+	if anchor == nil || axis == nil {
+		panic("Cannot create a wheel joint with a nil anchor or axis")
+	}
+	w := new(WheelJoint)
+	InitJoint(&w.Joint, body1, body2, false)
+	w.localAnchor1 = body1.GetLocalPoint(anchor)
+	w.localAnchor2 = body2.GetLocalPoint(anchor)
+	w.xAxis = body1.GetLocalVector(axis.GetNormalized())
+	w.yAxis = w.xAxis.CrossZ(1)
+	w.frequency = 8.0
+	w.dampingRatio = 0.4
+	return w
+}
+
+func (w *WheelJoint) InitializeConstraints(step *Step, settings *Settings) {
+	invM1 := w.body1.mass.GetInverseMass()
+	invM2 := w.body2.mass.GetInverseMass()
+	invI1 := w.body1.mass.GetInverseInertia()
+	invI2 := w.body2.mass.GetInverseInertia()
+	dt := step.GetDeltaTime()
+
+	r1 := w.getR1(w.localAnchor1)
+	r2 := w.getR2(w.localAnchor2)
+	d := w.body1.GetWorldCenter().AddVector2(r1).HereToVector2(w.body2.GetWorldCenter().AddVector2(r2))
+	dr1 := d.SumVector2(r1)
+
+	w.perp = w.body1.GetWorldVector(w.yAxis)
+	w.sAy = dr1.CrossVector2(w.perp)
+	w.sBy = r2.CrossVector2(w.perp)
+	w.invK = invM1 + invM2 + invI1*w.sAy*w.sAy + invI2*w.sBy*w.sBy
+	if w.invK > 0 {
+		w.invK = 1 / w.invK
+	}
+
+	w.axis = w.body1.GetWorldVector(w.xAxis)
+	w.sAx = dr1.CrossVector2(w.axis)
+	w.sBx = r2.CrossVector2(w.axis)
+	w.springMass = 0
+	w.bias = 0
+	w.gamma = 0
+	if w.frequency > 0 {
+		invMass := invM1 + invM2 + invI1*w.sAx*w.sAx + invI2*w.sBx*w.sBx
+		if invMass > 0 {
+			w.springMass = 1 / invMass
+			C := d.DotVector2(w.axis)
+			omega := geometry.TWO_PI * w.frequency
+			dc := 2 * w.springMass * w.dampingRatio * omega
+			k := w.springMass * omega * omega
+			w.gamma = dt * (dc + dt*k)
+			if w.gamma > 0 {
+				w.gamma = 1 / w.gamma
+			}
+			w.bias = C * dt * k * w.gamma
+			w.springMass = invMass + w.gamma
+			if w.springMass > 0 {
+				w.springMass = 1 / w.springMass
+			}
+		}
+	} else {
+		w.springImpulse = 0
+	}
+
+	if w.motorEnabled {
+		w.motorMass = invI1 + invI2
+		if w.motorMass > 0 {
+			w.motorMass = 1 / w.motorMass
+		}
+	} else {
+		w.motorMass = 0
+		w.motorImpulse = 0
+	}
+
+	if settings.IsWarmStartingEnabled() {
+		w.impulse *= step.GetDeltaTimeRatio()
+		w.springImpulse *= step.GetDeltaTimeRatio()
+		w.motorImpulse *= step.GetDeltaTimeRatio()
+	} else {
+		w.impulse = 0
+		w.springImpulse = 0
+		w.motorImpulse = 0
+	}
+
+	P := w.perp.Product(w.impulse).AddVector2(w.axis.Product(w.springImpulse))
+	L1 := w.impulse*w.sAy + w.springImpulse*w.sAx + w.motorImpulse
+	L2 := w.impulse*w.sBy + w.springImpulse*w.sBx + w.motorImpulse
+	w.applyImpulse(P, L1, L2)
+}
+
+func (w *WheelJoint) SolveVelocityConstraints(step *Step, settings *Settings) {
+	v1 := w.body1.velocity
+	v2 := w.body2.velocity
+
+	Cdot := w.axis.DotVector2(v1.HereToVector2(v2)) + w.sBx*w.body2.angularVelocity - w.sAx*w.body1.angularVelocity
+	impulse := -w.springMass * (Cdot + w.bias + w.gamma*w.springImpulse)
+	w.springImpulse += impulse
+	w.applyImpulse(w.axis.Product(impulse), impulse*w.sAx, impulse*w.sBx)
+
+	if w.motorEnabled {
+		Cdot = w.body2.angularVelocity - w.body1.angularVelocity + w.motorSpeed
+		impulse = -w.motorMass * Cdot
+		oldImpulse := w.motorImpulse
+		maxImpulse := w.maximumMotorTorque * step.GetDeltaTime()
+		w.motorImpulse = geometry.IntervalClamp(w.motorImpulse+impulse, -maxImpulse, maxImpulse)
+		impulse = w.motorImpulse - oldImpulse
+		w.applyImpulse(new(geometry.Vector2), impulse, impulse)
+	}
+
+	Cdot = w.perp.DotVector2(v1.HereToVector2(v2)) + w.sBy*w.body2.angularVelocity - w.sAy*w.body1.angularVelocity
+	impulse = -w.invK * Cdot
+	w.impulse += impulse
+	w.applyImpulse(w.perp.Product(impulse), impulse*w.sAy, impulse*w.sBy)
+}
+
+func (w *WheelJoint) SolvePositionConstraints(step *Step, settings *Settings) bool {
+	invM1 := w.body1.mass.GetInverseMass()
+	invM2 := w.body2.mass.GetInverseMass()
+	invI1 := w.body1.mass.GetInverseInertia()
+	invI2 := w.body2.mass.GetInverseInertia()
+
+	r1 := w.getR1(w.localAnchor1)
+	r2 := w.getR2(w.localAnchor2)
+	d := w.body1.GetWorldCenter().AddVector2(r1).HereToVector2(w.body2.GetWorldCenter().AddVector2(r2))
+
+	perp := w.body1.GetWorldVector(w.yAxis)
+	sAy := d.SumVector2(r1).CrossVector2(perp)
+	sBy := r2.CrossVector2(perp)
+
+	C := d.DotVector2(perp)
+	k := invM1 + invM2 + invI1*sAy*sAy + invI2*sBy*sBy
+	impulse := 0.0
+	if k != 0 {
+		impulse = -C / k
+	}
+
+	w.applyPositionImpulse(perp.Product(impulse), impulse*sAy, impulse*sBy)
+
+	return math.Abs(C) <= settings.GetLinearTolerance()
+}
+
+func (w *WheelJoint) GetAnchor1() *geometry.Vector2 {
+	return w.body1.GetWorldPoint(w.localAnchor1)
+}
+
+func (w *WheelJoint) GetAnchor2() *geometry.Vector2 {
+	return w.body2.GetWorldPoint(w.localAnchor2)
+}
+
+func (w *WheelJoint) GetReactionForce(invdt float64) *geometry.Vector2 {
+	return w.perp.Product(w.impulse).AddVector2(w.axis.Product(w.springImpulse)).Multiply(invdt)
+}
+
+func (w *WheelJoint) GetReactionTorque(invdt float64) float64 {
+	return w.motorImpulse * invdt
+}
+
+func (w *WheelJoint) GetJointTranslation() float64 {
+	d := w.GetAnchor1().HereToVector2(w.GetAnchor2())
+	return d.DotVector2(w.body1.GetWorldVector(w.xAxis))
+}
+
+func (w *WheelJoint) GetJointSpeed() float64 {
+	return w.body1.angularVelocity - w.body2.angularVelocity
+}
+
+func (w *WheelJoint) GetAxis() *geometry.Vector2 {
+	return w.body1.GetWorldVector(w.xAxis)
+}
+
+func (w *WheelJoint) GetFrequency() float64 {
+	return w.frequency
+}
+
+func (w *WheelJoint) SetFrequency(frequency float64) {
+	if frequency < 0 {
+		panic("Frequency must not be negative")
+	}
+	w.frequency = frequency
+	w.wakeBodies()
+}
+
+func (w *WheelJoint) GetDampingRatio() float64 {
+	return w.dampingRatio
+}
+
+func (w *WheelJoint) SetDampingRatio(dampingRatio float64) {
+	if dampingRatio < 0 || dampingRatio > 1 {
+		panic("Damping ratio must be between 0 and 1 inclusive")
+	}
+	w.dampingRatio = dampingRatio
+	w.wakeBodies()
+}
+
+func (w *WheelJoint) IsMotorEnabled() bool {
+	return w.motorEnabled
+}
+
+func (w *WheelJoint) SetMotorEnabled(flag bool) {
+	w.motorEnabled = flag
+	w.wakeBodies()
+}
+
+func (w *WheelJoint) GetMotorSpeed() float64 {
+	return w.motorSpeed
+}
+
+func (w *WheelJoint) SetMotorSpeed(motorSpeed float64) {
+	w.motorSpeed = motorSpeed
+	w.wakeBodies()
+}
+
+func (w *WheelJoint) GetMaximumMotorTorque() float64 {
+	return w.maximumMotorTorque
+}
+
+func (w *WheelJoint) SetMaximumMotorTorque(maximumMotorTorque float64) {
+	if maximumMotorTorque < 0 {
+		panic("Maximum motor torque must not be negative")
+	}
+	w.maximumMotorTorque = maximumMotorTorque
+	w.wakeBodies()
+}
+
+func (w *WheelJoint) GetMotorTorque(invdt float64) float64 {
+	return -w.motorImpulse * invdt
+}
@@ -0,0 +1,127 @@
+package dynamics
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+/**
+ * Tests that the suspension spring holds the wheel on the axis.
+ */
+func TestWheelJointSuspension(t *testing.T) {
+	chassis := createWorldTestBody(geometry.CreateRectangle(2.0, 0.5), geometry.INFINITE)
+	wheel := createWorldTestBody(geometry.CreateCircle(0.25), geometry.NORMAL)
+	wheel.TranslateXY(0.0, -1.0)
+	j := NewWheelJoint(chassis, wheel, wheel.GetWorldCenter(), geometry.NewVector2FromXY(0.0, 1.0))
+	w := NewWorld()
+	w.AddBody(chassis)
+	w.AddBody(wheel)
+	w.AddJoint(j)
+	wheel.SetVelocity(geometry.NewVector2FromXY(1.0, 0.0))
+
+	w.StepN(240)
+	translation := j.GetJointTranslation()
+	dyn4go.AssertTrue(t, translation < 0.0)
+	dyn4go.AssertTrue(t, translation > -0.1)
+	dyn4go.AssertEqualWithinError(t, 0.0, wheel.GetWorldCenter().X, 0.01)
+	dyn4go.AssertEqualWithinError(t, 0.0, wheel.GetVelocity().Y, 0.01)
+
+	j.SetFrequency(0.0)
+	w.StepN(60)
+	dyn4go.AssertTrue(t, j.GetJointTranslation() < translation-1.0)
+}
+
+/**
+ * Tests that the motor drives the wheel.
+ */
+func TestWheelJointMotor(t *testing.T) {
+	chassis := createWorldTestBody(geometry.CreateRectangle(2.0, 0.5), geometry.INFINITE)
+	wheel := createWorldTestBody(geometry.CreateCircle(0.25), geometry.NORMAL)
+	wheel.TranslateXY(0.0, -1.0)
+	j := NewWheelJoint(chassis, wheel, wheel.GetWorldCenter(), geometry.NewVector2FromXY(0.0, 1.0))
+	w := NewWorld()
+	w.AddBody(chassis)
+	w.AddBody(wheel)
+	w.AddJoint(j)
+	j.SetMotorEnabled(true)
+	j.SetMotorSpeed(5.0)
+	j.SetMaximumMotorTorque(100.0)
+
+	w.StepN(10)
+	dyn4go.AssertEqualWithinError(t, 5.0, j.GetJointSpeed(), 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, -5.0, wheel.GetAngularVelocity(), 1.0e-3)
+}
+
+/**
+ * Tests that the motor turns the wheel the same way as a revolute joint motor
+ * with the same speed.
+ */
+func TestWheelJointMotorDirection(t *testing.T) {
+	chassis := createWorldTestBody(geometry.CreateRectangle(2.0, 0.5), geometry.INFINITE)
+	wheel := createWorldTestBody(geometry.CreateCircle(0.25), geometry.NORMAL)
+	wheel.TranslateXY(0.0, -1.0)
+	j := NewWheelJoint(chassis, wheel, wheel.GetWorldCenter(), geometry.NewVector2FromXY(0.0, 1.0))
+	w := NewWorld()
+	w.AddBody(chassis)
+	w.AddBody(wheel)
+	w.AddJoint(j)
+	j.SetMotorEnabled(true)
+	j.SetMotorSpeed(2.0)
+	j.SetMaximumMotorTorque(100.0)
+	w.StepN(10)
+
+	rw, ground, box := createJointTestWorld()
+	rj := NewRevoluteJoint(ground, box, box.GetWorldCenter())
+	rj.SetMotorEnabled(true)
+	rj.SetMotorSpeed(2.0)
+	rj.SetMaximumMotorTorque(100.0)
+	rw.AddJoint(rj)
+	rw.StepN(10)
+
+	dyn4go.AssertEqualWithinError(t, rj.GetJointSpeed(), j.GetJointSpeed(), 1.0e-3)
+	dyn4go.AssertEqualWithinError(t, box.GetAngularVelocity(), wheel.GetAngularVelocity(), 1.0e-3)
+	dyn4go.AssertTrue(t, wheel.GetAngularVelocity() < 0)
+}
+
+/**
+ * Tests that accumulated impulses are not applied when warm starting is disabled.
+ */
+func TestWheelJointWarmStartDisabled(t *testing.T) {
+	chassis := createWorldTestBody(geometry.CreateRectangle(2.0, 0.5), geometry.INFINITE)
+	wheel := createWorldTestBody(geometry.CreateCircle(0.25), geometry.NORMAL)
+	wheel.TranslateXY(0.0, -1.0)
+	j := NewWheelJoint(chassis, wheel, wheel.GetWorldCenter(), geometry.NewVector2FromXY(0.0, 1.0))
+	w := NewWorld()
+	w.AddBody(chassis)
+	w.AddBody(wheel)
+	w.AddJoint(j)
+	j.SetMotorEnabled(true)
+	j.SetMotorSpeed(5.0)
+	j.SetMaximumMotorTorque(100.0)
+	w.StepN(30)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).GetMagnitude() > 0)
+
+	settings := w.GetSettings()
+	settings.SetWarmStartingEnabled(false)
+	v := geometry.NewVector2FromVector2(wheel.GetVelocity())
+	av := wheel.GetAngularVelocity()
+	j.InitializeConstraints(w.GetStep(), settings)
+	dyn4go.AssertTrue(t, j.GetReactionForce(w.GetStep().GetInverseDeltaTime()).IsZero())
+	dyn4go.AssertEqual(t, 0.0, j.GetMotorTorque(w.GetStep().GetInverseDeltaTime()))
+	dyn4go.AssertTrue(t, v.EqualsVector2(wheel.GetVelocity()))
+	dyn4go.AssertEqual(t, av, wheel.GetAngularVelocity())
+}
+
+/**
+ * Tests setting an invalid damping ratio.
+ */
+func TestWheelJointDampingRatio(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	chassis := createWorldTestBody(geometry.CreateRectangle(2.0, 0.5), geometry.INFINITE)
+	wheel := createWorldTestBody(geometry.CreateCircle(0.25), geometry.NORMAL)
+	wheel.TranslateXY(0.0, -1.0)
+	j := NewWheelJoint(chassis, wheel, wheel.GetWorldCenter(), geometry.NewVector2FromXY(0.0, 1.0))
+	j.SetDampingRatio(1.5)
+}
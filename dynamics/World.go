@@ -0,0 +1,890 @@
+package dynamics
+
+import (
+	"math"
+	"sort"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/collision"
+	"github.com/LSFN/dyn4go/collision/broadphase"
+	"github.com/LSFN/dyn4go/collision/continuous"
+	"github.com/LSFN/dyn4go/collision/manifold"
+	"github.com/LSFN/dyn4go/collision/narrowphase"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+var (
+	EARTH_GRAVITY = geometry.Vector2{X: 0, Y: -9.8}
+	ZERO_GRAVITY  = geometry.Vector2{X: 0, Y: 0}
+)
+
+type World struct {
+	settings                 *Settings
+	step                     *Step
+	gravity                  *geometry.Vector2
+	broadphaseDetector       broadphase.BroadphaseDetector
+	narrowphaseDetector      narrowphase.NarrowphaseDetector
+	narrowphasePostProcessor narrowphase.NarrowphasePostProcessor
+	distanceDetector         narrowphase.DistanceDetector
+	raycastDetector          narrowphase.RaycastDetector
+	manifoldSolver           manifold.ManifoldSolver
+	timeOfImpactDetector     continuous.TimeOfImpactDetector
+	timeOfImpactSolver       *TimeOfImpactSolver
+	coefficientMixer         CoefficientMixer
+	contactSolver            ContactConstraintSolver
+	bodies                   []*Body
+	joints                   []Jointer
+	contactManager           *ContactManager
+	constraintGraph          *ConstraintGraph
+	islandWorkers            []*islandWorker
+	listeners                []dyn4go.Listener
+	time                     float64
+	updateRequired           bool
+}
+
+func NewWorld() *World {
+	return NewWorldInt(64)
+}
+
+func NewWorldInt(initialBodyCapacity int) *World {
+	w := new(World)
+	w.settings = NewSettings()
+	w.step = NewStep(w.settings.GetStepFrequency())
+	w.gravity = geometry.NewVector2FromVector2(&EARTH_GRAVITY)
+	w.broadphaseDetector = broadphase.NewDynamicAABBTreeInt(initialBodyCapacity)
+	w.narrowphaseDetector = narrowphase.NewGJK()
+	w.narrowphasePostProcessor = new(narrowphase.LinkPostProcessor)
+	w.distanceDetector = narrowphase.NewGJK()
+	w.raycastDetector = narrowphase.NewGJK()
+	w.manifoldSolver = new(manifold.ClippingManifoldSolver)
+	w.timeOfImpactDetector = continuous.NewConservativeAdvancement(narrowphase.NewGJK())
+	w.timeOfImpactSolver = new(TimeOfImpactSolver)
+	w.coefficientMixer = DEFAULT_MIXER
+	w.contactSolver = new(SequentialImpulses)
+	w.bodies = make([]*Body, 0, initialBodyCapacity)
+	w.joints = make([]Jointer, 0, initialBodyCapacity/2)
+	w.contactManager = NewContactManager()
+	w.constraintGraph = NewConstraintGraphInt(initialBodyCapacity)
+	w.listeners = make([]dyn4go.Listener, 0)
+	w.updateRequired = true
+	return w
+}
+
+func (w *World) Update(elapsedTime float64) bool {
+	return w.UpdateMaxSteps(elapsedTime, 1)
+}
+
+func (w *World) UpdateMaxSteps(elapsedTime float64, maximumSteps int) bool {
+	if elapsedTime < 0 {
+		panic("Elapsed time must not be negative")
+	}
+	stepTime := w.settings.GetStepFrequency()
+	w.time += elapsedTime
+	steps := 0
+	for w.time >= stepTime && steps < maximumSteps {
+		w.step.update(stepTime)
+		w.doStep()
+		w.time -= stepTime
+		steps++
+	}
+	return steps > 0
+}
+
+func (w *World) Step(dt float64) {
+	if dt <= 0 {
+		panic("Step time must be strictly positive")
+	}
+	w.step.update(dt)
+	w.doStep()
+}
+
+func (w *World) StepN(steps int) {
+	if steps <= 0 {
+		panic("Number of steps must be strictly positive")
+	}
+	stepTime := w.settings.GetStepFrequency()
+	for i := 0; i < steps; i++ {
+		w.step.update(stepTime)
+		w.doStep()
+	}
+}
+
+func (w *World) doStep() {
+	if w.updateRequired {
+		w.detect()
+		w.updateRequired = false
+	}
+	w.solve()
+	w.solveTimeOfImpact()
+	w.detect()
+}
+
+func (w *World) solve() {
+	dt := w.step.dt
+	for _, body := range w.bodies {
+		body.transform0.Set(body.transform)
+		if body.IsDynamic() && !body.asleep {
+			invM := body.mass.GetInverseMass()
+			invI := body.mass.GetInverseInertia()
+			if invM > 0 {
+				body.velocity.X += (body.force.X*invM + w.gravity.X*body.gravityScale) * dt
+				body.velocity.Y += (body.force.Y*invM + w.gravity.Y*body.gravityScale) * dt
+			}
+			if invI > 0 {
+				body.angularVelocity += dt * invI * body.torque
+			}
+			linear := geometry.IntervalClamp(1-dt*body.linearDamping, 0, 1)
+			angular := geometry.IntervalClamp(1-dt*body.angularDamping, 0, 1)
+			body.velocity.Multiply(linear)
+			body.angularVelocity *= angular
+		}
+		body.ClearForce()
+		body.ClearTorque()
+	}
+	contactListeners := w.GetContactListeners()
+	contactConstraints := make([]*ContactConstraint, 0, len(w.contactManager.GetContactConstraints()))
+	for _, contactConstraint := range w.contactManager.GetContactConstraints() {
+		if contactConstraint.sensor || !contactConstraint.enabled {
+			continue
+		}
+		if !contactConstraint.body1.isAwake() && !contactConstraint.body2.isAwake() {
+			continue
+		}
+		for _, contact := range contactConstraint.contacts {
+			point := newContactPoint(contact.state, contactConstraint, contact)
+			for _, listener := range contactListeners {
+				if !listener.PreSolve(point) {
+					contactConstraint.enabled = false
+				}
+			}
+		}
+		if contactConstraint.enabled {
+			contactConstraints = append(contactConstraints, contactConstraint)
+		}
+	}
+	for _, body := range w.bodies {
+		if body.IsKinematic() {
+			body.integratePosition(w.step, w.settings)
+		}
+	}
+	w.constraintGraph.Clear()
+	for _, body := range w.bodies {
+		w.constraintGraph.AddBody(body)
+	}
+	for _, joint := range w.joints {
+		w.constraintGraph.AddJoint(joint)
+	}
+	for _, contactConstraint := range contactConstraints {
+		w.constraintGraph.AddContactConstraint(contactConstraint)
+	}
+	islands := w.constraintGraph.FindIslands()
+	workerCount := w.settings.GetIslandSolverWorkerCount()
+	if workerCount > len(islands) {
+		workerCount = len(islands)
+	}
+	var workers []*islandWorker
+	if workerCount > 1 {
+		workers = w.getIslandWorkers(workerCount)
+	}
+	if len(workers) > 1 {
+		solveIslands(islands, workers, w.step, w.settings)
+	} else {
+		for _, island := range islands {
+			island.solve(w.contactSolver, w.step, w.settings)
+		}
+	}
+	for _, contactConstraint := range contactConstraints {
+		for _, contact := range contactConstraint.contacts {
+			point := newSolvedContactPoint(contactConstraint, contact)
+			for _, listener := range contactListeners {
+				listener.PostSolve(point)
+			}
+		}
+	}
+}
+
+func (w *World) solveTimeOfImpact() {
+	mode := w.settings.GetContinuousDetectionMode()
+	if mode == CONTINUOUS_DETECTION_MODE_NONE {
+		return
+	}
+	for _, body := range w.bodies {
+		if !body.IsDynamic() || body.asleep {
+			continue
+		}
+		if mode == CONTINUOUS_DETECTION_MODE_BULLETS_ONLY && !body.bullet {
+			continue
+		}
+		w.solveBodyTimeOfImpact(body)
+	}
+}
+
+func (w *World) solveBodyTimeOfImpact(body1 *Body) {
+	aabb1 := body1.CreateSweptAABB()
+	tx1 := body1.transform0
+	dp1, da1 := getTransformDelta(tx1, body1.transform)
+	t2 := 1.0
+	var minToi *continuous.TimeOfImpact
+	var minBody *Body
+	for _, body2 := range w.bodies {
+		if body1 == body2 {
+			continue
+		}
+		if body2.IsDynamic() && (!body1.bullet || body2.bullet) {
+			continue
+		}
+		if body1.IsConnectedCollisionAllowed(body2, false) {
+			continue
+		}
+		if !aabb1.Overlaps(body2.CreateSweptAABB()) {
+			continue
+		}
+		tx2 := body2.transform0
+		dp2, da2 := getTransformDelta(tx2, body2.transform)
+		for _, fixture1 := range body1.fixtures {
+			if fixture1.IsSensor() {
+				continue
+			}
+			for _, fixture2 := range body2.fixtures {
+				if fixture2.IsSensor() || !fixture1.GetFilter().IsAllowed(fixture2.GetFilter()) {
+					continue
+				}
+				if w.contactManager.GetContactConstraint(NewContactConstraintID(fixture1, fixture2)) != nil {
+					continue
+				}
+				toi := new(continuous.TimeOfImpact)
+				if !w.timeOfImpactDetector.GetTimeOfImpactBounded(fixture1.GetShape(), tx1, dp1, da1, fixture2.GetShape(), tx2, dp2, da2, 0, t2, toi) {
+					continue
+				}
+				if toi.GetTime() < t2 {
+					t2 = toi.GetTime()
+					minToi = toi
+					minBody = body2
+				}
+			}
+		}
+	}
+	if minToi == nil {
+		return
+	}
+	t := minToi.GetTime()
+	body1.transform0.Lerp(body1.transform, t)
+	body1.transform.Set(body1.transform0)
+	if minBody.IsDynamic() {
+		minBody.transform0.Lerp(minBody.transform, t)
+		minBody.transform.Set(minBody.transform0)
+	}
+	w.timeOfImpactSolver.Solve(body1, minBody, minToi, w.settings)
+}
+
+func getTransformDelta(initialTransform, finalTransform *geometry.Transform) (*geometry.Vector2, float64) {
+	dp := geometry.NewVector2FromXY(finalTransform.X-initialTransform.X, finalTransform.Y-initialTransform.Y)
+	da := math.Remainder(finalTransform.GetRotation()-initialTransform.GetRotation(), geometry.TWO_PI)
+	return dp, da
+}
+
+func (w *World) detect() {
+	for _, body := range w.bodies {
+		if !body.asleep {
+			w.broadphaseDetector.Update(body)
+		}
+	}
+	for _, contactConstraint := range w.contactManager.GetContactConstraints() {
+		if !contactConstraint.body1.isAwake() && !contactConstraint.body2.isAwake() {
+			w.contactManager.Queue(contactConstraint)
+		}
+	}
+	speculative := w.settings.GetContinuousDetectionMode() == CONTINUOUS_DETECTION_MODE_SPECULATIVE
+	pairs := w.broadphaseDetector.Detect()
+	for _, pair := range pairs {
+		body1 := pair.GetA().(*Body)
+		body2 := pair.GetB().(*Body)
+		if !body1.IsDynamic() && !body2.IsDynamic() {
+			continue
+		}
+		if !body1.isAwake() && !body2.isAwake() {
+			continue
+		}
+		if body1.IsConnectedCollisionAllowed(body2, false) {
+			continue
+		}
+		transform1 := body1.GetTransform()
+		transform2 := body2.GetTransform()
+		for _, fixture1 := range body1.fixtures {
+			for _, fixture2 := range body2.fixtures {
+				if !fixture1.GetFilter().IsAllowed(fixture2.GetFilter()) {
+					continue
+				}
+				convex1 := fixture1.GetShape()
+				convex2 := fixture2.GetShape()
+				penetration := narrowphase.NewPenetration()
+				if !w.broadphaseDetector.DetectConvexTransform(convex1, transform1, convex2, transform2) ||
+					!w.narrowphaseDetector.DetectPenetration(convex1, transform1, convex2, transform2, penetration) {
+					if speculative {
+						w.detectSpeculativeContact(body1, fixture1, body2, fixture2)
+					}
+					continue
+				}
+				if !w.narrowphasePostProcessor.Process(convex1, transform1, convex2, transform2, penetration) {
+					continue
+				}
+				if penetration.GetDepth() == 0 {
+					continue
+				}
+				m := manifold.NewManifold()
+				if !w.manifoldSolver.GetManifold(penetration, convex1, transform1, convex2, transform2, m) {
+					continue
+				}
+				w.contactManager.Queue(NewContactConstraint(body1, fixture1, body2, fixture2, m, w.coefficientMixer))
+			}
+		}
+	}
+	w.contactManager.UpdateContacts(w.settings)
+	w.wakeContacts()
+	w.notifyContacts()
+}
+
+func (w *World) detectSpeculativeContact(body1 *Body, fixture1 *BodyFixture, body2 *Body, fixture2 *BodyFixture) {
+	separation := narrowphase.NewSeparation()
+	if !w.distanceDetector.Distance(fixture1.GetShape(), body1.transform, fixture2.GetShape(), body2.transform, separation) {
+		return
+	}
+	if !w.narrowphasePostProcessor.ProcessSeparation(fixture1.GetShape(), body1.transform, fixture2.GetShape(), body2.transform, separation) {
+		return
+	}
+	n := separation.GetNormal()
+	p1 := separation.GetPoint1()
+	p2 := separation.GetPoint2()
+	v1 := body1.GetWorldCenter().HereToVector2(p1).CrossZ(body1.angularVelocity).AddVector2(body1.velocity)
+	v2 := body2.GetWorldCenter().HereToVector2(p2).CrossZ(body2.angularVelocity).AddVector2(body2.velocity)
+	closing := v1.SubtractVector2(v2).DotVector2(n)
+	if closing <= 0 {
+		return
+	}
+	distance := separation.GetDistance()
+	if distance > math.Min(closing*w.step.dt, w.broadphaseDetector.GetAABBExpansion()) {
+		return
+	}
+	p := p1.SumVector2(p2).Multiply(0.5)
+	point := manifold.NewManifoldPointInterfaceVector2Float64(manifold.DISTANCE, p, -distance)
+	m := manifold.NewManifoldManifoldPointsVector2([]*manifold.ManifoldPoint{point}, n.GetNegative())
+	w.contactManager.Queue(NewContactConstraint(body1, fixture1, body2, fixture2, m, w.coefficientMixer))
+}
+
+func (w *World) wakeContacts() {
+	for _, point := range w.contactManager.GetContactPoints() {
+		if point.sensor || point.state == CONTACT_POINT_PERSIST {
+			continue
+		}
+		if point.body1.asleep {
+			point.body1.SetAsleep(false)
+		}
+		if point.body2.asleep {
+			point.body2.SetAsleep(false)
+		}
+	}
+}
+
+func (w *World) notifyContacts() {
+	contactListeners := w.GetContactListeners()
+	for _, point := range w.contactManager.GetContactPoints() {
+		allowed := true
+		for _, listener := range contactListeners {
+			switch point.state {
+			case CONTACT_POINT_BEGIN:
+				allowed = listener.Begin(point) && allowed
+			case CONTACT_POINT_PERSIST:
+				allowed = listener.Persist(point) && allowed
+			case CONTACT_POINT_END:
+				listener.End(point)
+			}
+			if point.sensor && point.state != CONTACT_POINT_END {
+				listener.Sensed(point)
+			}
+		}
+		if !allowed {
+			point.contactConstraint.enabled = false
+		}
+	}
+}
+
+func (w *World) Raycast(ray *geometry.Ray, maxLength float64, filter collision.Filterer) []*RaycastResult {
+	return w.RaycastMode(ray, maxLength, filter, RAYCAST_MODE_ALL)
+}
+
+func (w *World) RaycastMode(ray *geometry.Ray, maxLength float64, filter collision.Filterer, mode int) []*RaycastResult {
+	if ray == nil {
+		panic("Cannot raycast with a nil ray")
+	}
+	if mode < RAYCAST_MODE_ALL || mode > RAYCAST_MODE_ANY {
+		panic("Invalid raycast mode")
+	}
+	results := make([]*RaycastResult, 0)
+	var closest *RaycastResult
+	length := maxLength
+	for _, collider := range w.broadphaseDetector.Raycast(ray, maxLength) {
+		body := collider.(*Body)
+		transform := body.GetTransform()
+		for _, fixture := range body.fixtures {
+			if !isQueryAllowed(fixture, filter) {
+				continue
+			}
+			raycast := narrowphase.NewRaycast()
+			if !w.raycastDetector.Raycast(ray, length, fixture.GetShape(), transform, raycast) {
+				continue
+			}
+			result := NewRaycastResult(body, fixture, raycast)
+			switch mode {
+			case RAYCAST_MODE_ANY:
+				return []*RaycastResult{result}
+			case RAYCAST_MODE_CLOSEST:
+				if closest == nil || raycast.GetDistance() < closest.GetDistance() {
+					closest = result
+					if raycast.GetDistance() > 0 {
+						length = raycast.GetDistance()
+					}
+				}
+			default:
+				results = append(results, result)
+			}
+		}
+	}
+	if closest != nil {
+		return []*RaycastResult{closest}
+	}
+	sort.Sort(raycastResultList(results))
+	return results
+}
+
+func (w *World) ConvexCast(convex geometry.Convexer, transform *geometry.Transform, dp *geometry.Vector2, da float64, filter collision.Filterer) *ConvexCastResult {
+	if convex == nil || transform == nil || dp == nil {
+		panic("Cannot convex cast with nil arguments")
+	}
+	zero := new(geometry.Vector2)
+	radius := convex.GetRadiusVector2(zero)
+	end := transform.LerpedDelta(dp, da, 1)
+	aabb := geometry.NewAABBFromCenterRadius(transform.GetTransformedVector2(zero), radius)
+	aabb.Union(geometry.NewAABBFromCenterRadius(end.GetTransformedVector2(zero), radius))
+	t2 := 1.0
+	var result *ConvexCastResult
+	for _, collider := range w.broadphaseDetector.DetectAABB(aabb) {
+		body := collider.(*Body)
+		transform2 := body.GetTransform()
+		for _, fixture := range body.fixtures {
+			if !isQueryAllowed(fixture, filter) {
+				continue
+			}
+			toi := new(continuous.TimeOfImpact)
+			if !w.timeOfImpactDetector.GetTimeOfImpactBounded(convex, transform, dp, da, fixture.GetShape(), transform2, zero, 0, 0, t2, toi) {
+				continue
+			}
+			if result == nil || toi.GetTime() < t2 {
+				t2 = toi.GetTime()
+				result = NewConvexCastResult(body, fixture, toi)
+			}
+		}
+	}
+	return result
+}
+
+func (w *World) DetectPoint(point *geometry.Vector2, filter collision.Filterer) []*DetectResult {
+	if point == nil {
+		panic("Cannot detect with a nil point")
+	}
+	results := make([]*DetectResult, 0)
+	for _, collider := range w.broadphaseDetector.DetectAABB(geometry.NewAABBFromCenterRadius(point, 0)) {
+		body := collider.(*Body)
+		transform := body.GetTransform()
+		for _, fixture := range body.fixtures {
+			if !isQueryAllowed(fixture, filter) {
+				continue
+			}
+			if fixture.GetShape().ContainsVector2Transform(point, transform) {
+				results = append(results, NewDetectResult(body, fixture))
+			}
+		}
+	}
+	return results
+}
+
+func (w *World) DetectAABB(aabb *geometry.AABB, filter collision.Filterer) []*DetectResult {
+	if aabb == nil {
+		panic("Cannot detect with a nil AABB")
+	}
+	min := geometry.NewVector2FromXY(aabb.GetMinX(), aabb.GetMinY())
+	max := geometry.NewVector2FromXY(aabb.GetMaxX(), aabb.GetMaxY())
+	width := aabb.GetWidth()
+	height := aabb.GetHeight()
+	if width == 0 && height == 0 {
+		return w.DetectPoint(min, filter)
+	}
+	transform := geometry.NewTransform()
+	if width == 0 || height == 0 {
+		return w.DetectConvex(geometry.CreateSegment(min, max), transform, filter)
+	}
+	transform.TranslateXY(min.X+width*0.5, min.Y+height*0.5)
+	return w.DetectConvex(geometry.CreateRectangle(width, height), transform, filter)
+}
+
+func (w *World) DetectConvex(convex geometry.Convexer, transform *geometry.Transform, filter collision.Filterer) []*DetectResult {
+	if convex == nil || transform == nil {
+		panic("Cannot detect with nil arguments")
+	}
+	aabb := convex.CreateAABBTransform(transform)
+	results := make([]*DetectResult, 0)
+	for _, collider := range w.broadphaseDetector.DetectAABB(aabb) {
+		body := collider.(*Body)
+		transform2 := body.GetTransform()
+		for _, fixture := range body.fixtures {
+			if !isQueryAllowed(fixture, filter) {
+				continue
+			}
+			if !aabb.Overlaps(fixture.GetShape().CreateAABBTransform(transform2)) {
+				continue
+			}
+			if w.narrowphaseDetector.Detect(convex, transform, fixture.GetShape(), transform2) {
+				results = append(results, NewDetectResult(body, fixture))
+			}
+		}
+	}
+	return results
+}
+
+func isQueryAllowed(fixture *BodyFixture, filter collision.Filterer) bool {
+	if fixture.IsSensor() {
+		return false
+	}
+	return filter == nil || filter.IsAllowed(fixture.GetFilter())
+}
+
+func (w *World) AddBody(body *Body) {
+	if body == nil {
+		panic("Cannot add nil body to world")
+	}
+	if w.ContainsBody(body) {
+		panic("Body is already in this world")
+	}
+	w.bodies = append(w.bodies, body)
+	w.broadphaseDetector.Add(body)
+	w.updateRequired = true
+}
+
+func (w *World) RemoveBody(body *Body) bool {
+	for i, b := range w.bodies {
+		if b == body {
+			w.bodies = append(w.bodies[:i], w.bodies[i+1:]...)
+			for len(body.joints) > 0 {
+				w.RemoveJoint(body.joints[0])
+			}
+			w.broadphaseDetector.Remove(body)
+			w.contactManager.EndBody(body)
+			w.wakeContacts()
+			w.notifyContacts()
+			w.updateRequired = true
+			return true
+		}
+	}
+	return false
+}
+
+func (w *World) RemoveAllBodies() {
+	w.RemoveAllJoints()
+	w.bodies = w.bodies[:0]
+	w.broadphaseDetector.Clear()
+	w.contactManager.Clear()
+	w.notifyContacts()
+	w.updateRequired = true
+}
+
+func (w *World) ContainsBody(body *Body) bool {
+	for _, b := range w.bodies {
+		if b == body {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *World) GetBody(index int) *Body {
+	return w.bodies[index]
+}
+
+func (w *World) GetBodyCount() int {
+	return len(w.bodies)
+}
+
+func (w *World) GetBodies() []*Body {
+	return w.bodies
+}
+
+func (w *World) AddJoint(joint Jointer) {
+	if joint == nil {
+		panic("Cannot add nil joint to world")
+	}
+	if w.ContainsJoint(joint) {
+		panic("Joint is already in this world")
+	}
+	if g, ok := joint.(*GearJoint); ok && (!w.ContainsJoint(g.joint1) || !w.ContainsJoint(g.joint2)) {
+		panic("Gear joint's joints must be added to the world first")
+	}
+	w.joints = append(w.joints, joint)
+	body1 := joint.GetBody1()
+	body2 := joint.GetBody2()
+	body1.joints = append(body1.joints, joint)
+	if body2 != body1 {
+		body2.joints = append(body2.joints, joint)
+	}
+	body1.SetAsleep(false)
+	body2.SetAsleep(false)
+	w.updateRequired = true
+}
+
+func (w *World) RemoveJoint(joint Jointer) bool {
+	for i, j := range w.joints {
+		if j == joint {
+			w.joints = append(w.joints[:i], w.joints[i+1:]...)
+			joint.GetBody1().removeJoint(joint)
+			joint.GetBody2().removeJoint(joint)
+			joint.GetBody1().SetAsleep(false)
+			joint.GetBody2().SetAsleep(false)
+			w.updateRequired = true
+			return true
+		}
+	}
+	return false
+}
+
+func (w *World) RemoveAllJoints() {
+	for _, joint := range w.joints {
+		joint.GetBody1().removeJoint(joint)
+		joint.GetBody2().removeJoint(joint)
+	}
+	w.joints = w.joints[:0]
+	w.updateRequired = true
+}
+
+func (w *World) ContainsJoint(joint Jointer) bool {
+	for _, j := range w.joints {
+		if j == joint {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *World) GetJoint(index int) Jointer {
+	return w.joints[index]
+}
+
+func (w *World) GetJointCount() int {
+	return len(w.joints)
+}
+
+func (w *World) GetJoints() []Jointer {
+	return w.joints
+}
+
+func (w *World) GetContactConstraints() []*ContactConstraint {
+	return w.contactManager.GetContactConstraints()
+}
+
+func (w *World) GetContactManager() *ContactManager {
+	return w.contactManager
+}
+
+func (w *World) GetConstraintGraph() *ConstraintGraph {
+	return w.constraintGraph
+}
+
+func (w *World) AddListener(listener dyn4go.Listener) {
+	if listener == nil {
+		panic("Cannot add nil listener to world")
+	}
+	w.listeners = append(w.listeners, listener)
+}
+
+func (w *World) RemoveListener(listener dyn4go.Listener) bool {
+	for i, l := range w.listeners {
+		if l == listener {
+			w.listeners = append(w.listeners[:i], w.listeners[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (w *World) RemoveAllListeners() {
+	w.listeners = w.listeners[:0]
+}
+
+func (w *World) GetListeners() []dyn4go.Listener {
+	return w.listeners
+}
+
+func (w *World) GetContactListeners() []ContactListener {
+	contactListeners := make([]ContactListener, 0, len(w.listeners))
+	for _, listener := range w.listeners {
+		if contactListener, ok := listener.(ContactListener); ok {
+			contactListeners = append(contactListeners, contactListener)
+		}
+	}
+	return contactListeners
+}
+
+func (w *World) GetGravity() *geometry.Vector2 {
+	return w.gravity
+}
+
+func (w *World) SetGravity(gravity *geometry.Vector2) {
+	if gravity == nil {
+		panic("Cannot set gravity to nil")
+	}
+	w.gravity = geometry.NewVector2FromVector2(gravity)
+}
+
+func (w *World) GetSettings() *Settings {
+	return w.settings
+}
+
+func (w *World) SetSettings(settings *Settings) {
+	if settings == nil {
+		panic("Cannot set settings to nil")
+	}
+	w.settings = settings
+}
+
+func (w *World) GetStep() *Step {
+	return w.step
+}
+
+func (w *World) GetAccumulatedTime() float64 {
+	return w.time
+}
+
+func (w *World) SetAccumulatedTime(elapsedTime float64) {
+	if elapsedTime < 0 {
+		panic("Accumulated time must not be negative")
+	}
+	w.time = elapsedTime
+}
+
+func (w *World) GetBroadphaseDetector() broadphase.BroadphaseDetector {
+	return w.broadphaseDetector
+}
+
+func (w *World) SetBroadphaseDetector(broadphaseDetector broadphase.BroadphaseDetector) {
+	if broadphaseDetector == nil {
+		panic("Cannot set broadphase detector to nil")
+	}
+	broadphaseDetector.Clear()
+	for _, body := range w.bodies {
+		broadphaseDetector.Add(body)
+	}
+	w.broadphaseDetector = broadphaseDetector
+	w.updateRequired = true
+}
+
+func (w *World) GetNarrowphaseDetector() narrowphase.NarrowphaseDetector {
+	return w.narrowphaseDetector
+}
+
+func (w *World) SetNarrowphaseDetector(narrowphaseDetector narrowphase.NarrowphaseDetector) {
+	if narrowphaseDetector == nil {
+		panic("Cannot set narrowphase detector to nil")
+	}
+	w.narrowphaseDetector = narrowphaseDetector
+	w.updateRequired = true
+}
+
+func (w *World) GetNarrowphasePostProcessor() narrowphase.NarrowphasePostProcessor {
+	return w.narrowphasePostProcessor
+}
+
+func (w *World) SetNarrowphasePostProcessor(narrowphasePostProcessor narrowphase.NarrowphasePostProcessor) {
+	if narrowphasePostProcessor == nil {
+		panic("Cannot set narrowphase post processor to nil")
+	}
+	w.narrowphasePostProcessor = narrowphasePostProcessor
+	w.updateRequired = true
+}
+
+func (w *World) GetDistanceDetector() narrowphase.DistanceDetector {
+	return w.distanceDetector
+}
+
+func (w *World) SetDistanceDetector(distanceDetector narrowphase.DistanceDetector) {
+	if distanceDetector == nil {
+		panic("Cannot set distance detector to nil")
+	}
+	w.distanceDetector = distanceDetector
+	w.updateRequired = true
+}
+
+func (w *World) GetRaycastDetector() narrowphase.RaycastDetector {
+	return w.raycastDetector
+}
+
+func (w *World) SetRaycastDetector(raycastDetector narrowphase.RaycastDetector) {
+	if raycastDetector == nil {
+		panic("Cannot set raycast detector to nil")
+	}
+	w.raycastDetector = raycastDetector
+}
+
+func (w *World) GetManifoldSolver() manifold.ManifoldSolver {
+	return w.manifoldSolver
+}
+
+func (w *World) SetManifoldSolver(manifoldSolver manifold.ManifoldSolver) {
+	if manifoldSolver == nil {
+		panic("Cannot set manifold solver to nil")
+	}
+	w.manifoldSolver = manifoldSolver
+	w.updateRequired = true
+}
+
+func (w *World) GetTimeOfImpactDetector() continuous.TimeOfImpactDetector {
+	return w.timeOfImpactDetector
+}
+
+func (w *World) SetTimeOfImpactDetector(timeOfImpactDetector continuous.TimeOfImpactDetector) {
+	if timeOfImpactDetector == nil {
+		panic("Cannot set time of impact detector to nil")
+	}
+	w.timeOfImpactDetector = timeOfImpactDetector
+}
+
+func (w *World) GetCoefficientMixer() CoefficientMixer {
+	return w.coefficientMixer
+}
+
+func (w *World) SetCoefficientMixer(coefficientMixer CoefficientMixer) {
+	if coefficientMixer == nil {
+		panic("Cannot set coefficient mixer to nil")
+	}
+	w.coefficientMixer = coefficientMixer
+	w.updateRequired = true
+}
+
+func (w *World) GetContactConstraintSolver() ContactConstraintSolver {
+	return w.contactSolver
+}
+
+func (w *World) SetContactConstraintSolver(contactSolver ContactConstraintSolver) {
+	if contactSolver == nil {
+		panic("Cannot set contact constraint solver to nil")
+	}
+	w.contactSolver = contactSolver
+	w.islandWorkers = w.islandWorkers[:0]
+}
+
+func (w *World) getIslandWorkers(count int) []*islandWorker {
+	cloner, ok := w.contactSolver.(ContactConstraintSolverCloner)
+	if !ok {
+		return nil
+	}
+	for len(w.islandWorkers) < count {
+		w.islandWorkers = append(w.islandWorkers, newIslandWorker(cloner))
+	}
+	return w.islandWorkers[:count]
+}
@@ -0,0 +1,470 @@
+package dynamics
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/collision"
+	"github.com/LSFN/dyn4go/collision/narrowphase"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func createWorldTestBody(shape geometry.Convexer, massType int) *Body {
+	b := NewBody()
+	b.AddFixtureConvex(shape)
+	b.UpdateMassWithType(massType)
+	return b
+}
+
+/**
+ * Tests adding and removing bodies.
+ */
+func TestWorldAddRemoveBody(t *testing.T) {
+	w := NewWorld()
+	b := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	w.AddBody(b)
+	dyn4go.AssertEqual(t, 1, w.GetBodyCount())
+	dyn4go.AssertTrue(t, w.ContainsBody(b))
+	dyn4go.AssertTrue(t, w.GetBroadphaseDetector().GetAABB(b) != nil)
+
+	dyn4go.AssertTrue(t, w.RemoveBody(b))
+	dyn4go.AssertFalse(t, w.RemoveBody(b))
+	dyn4go.AssertEqual(t, 0, w.GetBodyCount())
+	dyn4go.AssertTrue(t, w.GetBroadphaseDetector().GetAABB(b) == nil)
+
+	bodies := make([]*Body, 5)
+	for i := range bodies {
+		bodies[i] = createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+		bodies[i].TranslateXY(float64(i)*3.0, 0.0)
+		w.AddBody(bodies[i])
+	}
+	for _, body := range bodies {
+		dyn4go.AssertTrue(t, w.RemoveBody(body))
+	}
+	dyn4go.AssertEqual(t, 0, w.GetBodyCount())
+}
+
+/**
+ * Tests adding the same body twice.
+ */
+func TestWorldAddBodyTwice(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	w := NewWorld()
+	b := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	w.AddBody(b)
+	w.AddBody(b)
+}
+
+/**
+ * Tests that the world keeps its own copy of the gravity vector.
+ */
+func TestWorldSetGravity(t *testing.T) {
+	w := NewWorld()
+	gravity := geometry.NewVector2FromXY(0.0, -1.0)
+	w.SetGravity(gravity)
+	gravity.Y = -100.0
+	dyn4go.AssertEqual(t, 0.0, w.GetGravity().X)
+	dyn4go.AssertEqual(t, -1.0, w.GetGravity().Y)
+}
+
+/**
+ * Tests that the update method only steps once the accumulated time
+ * reaches the step frequency.
+ */
+func TestWorldUpdate(t *testing.T) {
+	w := NewWorld()
+	b := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	w.AddBody(b)
+
+	dyn4go.AssertFalse(t, w.Update(DEFAULT_STEP_FREQUENCY*0.5))
+	dyn4go.AssertTrue(t, b.GetVelocity().IsZero())
+	dyn4go.AssertTrue(t, w.Update(DEFAULT_STEP_FREQUENCY*0.75))
+	dyn4go.AssertEqualWithinError(t, DEFAULT_STEP_FREQUENCY*0.25, w.GetAccumulatedTime(), 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, -9.8*DEFAULT_STEP_FREQUENCY, b.GetVelocity().Y, 1.0e-9)
+}
+
+/**
+ * Tests gravity and the different mass types during integration.
+ */
+func TestWorldStepIntegration(t *testing.T) {
+	w := NewWorld()
+	dynamic := createWorldTestBody(geometry.CreateCircle(1.0), geometry.NORMAL)
+	static := createWorldTestBody(geometry.CreateCircle(1.0), geometry.INFINITE)
+	static.TranslateXY(10.0, 0.0)
+	fixed := createWorldTestBody(geometry.CreateCircle(1.0), geometry.FIXED_LINEAR_VELOCITY)
+	fixed.TranslateXY(-10.0, 0.0)
+	w.AddBody(dynamic)
+	w.AddBody(static)
+	w.AddBody(fixed)
+
+	fixed.ApplyTorque(1.0)
+	w.Step(0.1)
+	dyn4go.AssertEqualWithinError(t, -0.98, dynamic.GetVelocity().Y, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, -0.098, dynamic.GetTransform().Y, 1.0e-9)
+	dyn4go.AssertEqual(t, 0.0, static.GetTransform().Y)
+	dyn4go.AssertEqual(t, 0.0, fixed.GetTransform().Y)
+	dyn4go.AssertTrue(t, fixed.GetAngularVelocity() > 0)
+	dyn4go.AssertEqual(t, 0.0, fixed.GetTorque())
+}
+
+/**
+ * Tests that overlapping bodies produce contact constraints and that
+ * filtered fixtures do not.
+ */
+func TestWorldDetect(t *testing.T) {
+	w := NewWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	floor := createWorldTestBody(geometry.CreateRectangle(10.0, 1.0), geometry.INFINITE)
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.TranslateXY(0.0, 0.9)
+	w.AddBody(floor)
+	w.AddBody(box)
+
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	constraints := w.GetContactConstraints()
+	dyn4go.AssertEqual(t, 1, len(constraints))
+	dyn4go.AssertEqual(t, 2, len(constraints[0].GetContacts()))
+	for _, c := range constraints[0].GetContacts() {
+		dyn4go.AssertTrue(t, c.GetDepth() > 0.0)
+		dyn4go.AssertTrue(t, c.GetDepth() < 0.1)
+	}
+	n := constraints[0].GetNormal()
+	if constraints[0].GetBody1() == floor {
+		n = n.GetNegative()
+	}
+	dyn4go.AssertEqualWithinError(t, 1.0, n.Y, 1.0e-9)
+
+	floor.GetBodyFixture(0).SetFilter(new(worldTestFilter))
+	box.GetBodyFixture(0).SetFilter(new(worldTestFilter))
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 0, len(w.GetContactConstraints()))
+}
+
+type worldTestFilter struct{}
+
+func (f *worldTestFilter) IsAllowed(filter collision.Filterer) bool {
+	return false
+}
+
+func createWorldTestProjectile(mode int, bullet bool) (*World, *Body) {
+	w := NewWorld()
+	w.SetGravity(geometry.NewVector2FromVector2(&ZERO_GRAVITY))
+	w.GetSettings().SetContinuousDetectionMode(mode)
+	wall := createWorldTestBody(geometry.CreateRectangle(0.1, 10.0), geometry.INFINITE)
+	wall.TranslateXY(5.0, 0.0)
+	projectile := createWorldTestBody(geometry.CreateCircle(0.1), geometry.NORMAL)
+	projectile.SetBullet(bullet)
+	projectile.SetVelocity(geometry.NewVector2FromXY(90.0, 0.0))
+	w.AddBody(wall)
+	w.AddBody(projectile)
+	return w, projectile
+}
+
+/**
+ * Tests that a fast projectile tunnels through a thin wall without continuous detection.
+ */
+func TestWorldContinuousDetectionNone(t *testing.T) {
+	w, projectile := createWorldTestProjectile(CONTINUOUS_DETECTION_MODE_NONE, true)
+	w.StepN(4)
+	dyn4go.AssertTrue(t, projectile.GetWorldCenter().X > 5.0)
+}
+
+/**
+ * Tests that a bullet is stopped by a thin wall.
+ */
+func TestWorldContinuousDetectionBullet(t *testing.T) {
+	w, projectile := createWorldTestProjectile(CONTINUOUS_DETECTION_MODE_BULLETS_ONLY, true)
+	w.StepN(4)
+	dyn4go.AssertTrue(t, projectile.GetWorldCenter().X < 5.0)
+	dyn4go.AssertEqualWithinError(t, 4.85, projectile.GetWorldCenter().X, 0.01)
+
+	w.StepN(10)
+	dyn4go.AssertTrue(t, projectile.GetWorldCenter().X < 5.0)
+	dyn4go.AssertEqualWithinError(t, 0.0, projectile.GetVelocity().X, 1.0e-9)
+
+	w, projectile = createWorldTestProjectile(CONTINUOUS_DETECTION_MODE_BULLETS_ONLY, false)
+	w.StepN(4)
+	dyn4go.AssertTrue(t, projectile.GetWorldCenter().X > 5.0)
+}
+
+/**
+ * Tests that every dynamic body is stopped by a thin wall when detecting all bodies.
+ */
+func TestWorldContinuousDetectionAll(t *testing.T) {
+	w, projectile := createWorldTestProjectile(CONTINUOUS_DETECTION_MODE_ALL, false)
+	w.StepN(10)
+	dyn4go.AssertTrue(t, projectile.GetWorldCenter().X < 5.0)
+}
+
+/**
+ * Tests that speculative contacts stop a fast body at a thin wall.
+ */
+func TestWorldContinuousDetectionSpeculative(t *testing.T) {
+	w, projectile := createWorldTestProjectile(CONTINUOUS_DETECTION_MODE_SPECULATIVE, false)
+	w.GetBroadphaseDetector().SetAABBExpansion(2.0)
+	w.StepN(3)
+	dyn4go.AssertEqual(t, 1, len(w.GetContactConstraints()))
+	contact := w.GetContactConstraints()[0].GetContacts()[0]
+	dyn4go.AssertEqualWithinError(t, -0.35, contact.GetDepth(), 1.0e-9)
+	w.StepN(10)
+	dyn4go.AssertTrue(t, projectile.GetWorldCenter().X < 5.0)
+	dyn4go.AssertEqualWithinError(t, 4.85, projectile.GetWorldCenter().X, 0.01)
+	dyn4go.AssertEqualWithinError(t, 0.0, projectile.GetVelocity().X, 1.0e-9)
+}
+
+/**
+ * Tests that speculative contacts are not created for bodies moving apart.
+ */
+func TestWorldContinuousDetectionSpeculativeSeparating(t *testing.T) {
+	w, projectile := createWorldTestProjectile(CONTINUOUS_DETECTION_MODE_SPECULATIVE, false)
+	w.GetBroadphaseDetector().SetAABBExpansion(2.0)
+	projectile.TranslateXY(4.0, 0.0)
+	projectile.SetVelocity(geometry.NewVector2FromXY(-1.0, 0.0))
+	w.Step(DEFAULT_STEP_FREQUENCY)
+	dyn4go.AssertEqual(t, 0, len(w.GetContactConstraints()))
+}
+
+/**
+ * Tests setting an invalid continuous detection mode.
+ */
+func TestWorldContinuousDetectionModeInvalid(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	NewSettings().SetContinuousDetectionMode(-1)
+}
+
+type worldTestExcludeFilter struct {
+	excluded collision.Filterer
+}
+
+func (f *worldTestExcludeFilter) IsAllowed(filter collision.Filterer) bool {
+	return filter != f.excluded
+}
+
+func createWorldTestRaycast() (*World, []*Body) {
+	w := NewWorld()
+	shapes := []geometry.Convexer{
+		geometry.CreateSquare(1.0),
+		geometry.CreateCircle(0.5),
+		geometry.CreateSegment(geometry.NewVector2FromXY(0.0, -1.0), geometry.NewVector2FromXY(0.0, 1.0)),
+		geometry.CreateUnitCirclePolygon(6, 0.5),
+	}
+	bodies := make([]*Body, len(shapes))
+	for i, shape := range shapes {
+		bodies[i] = createWorldTestBody(shape, geometry.INFINITE)
+		bodies[i].TranslateXY(8.0-2.0*float64(i), 0.0)
+		w.AddBody(bodies[i])
+	}
+	return w, bodies
+}
+
+/**
+ * Tests that a world raycast returns every fixture hit sorted by distance.
+ */
+func TestWorldRaycastAll(t *testing.T) {
+	w, bodies := createWorldTestRaycast()
+	ray := geometry.NewRayFromVector2Vector2(new(geometry.Vector2), geometry.NewVector2FromXY(1.0, 0.0))
+	results := w.Raycast(ray, 0, nil)
+	dyn4go.AssertEqual(t, 4, len(results))
+	distances := []float64{1.5, 4.0, 5.5, 7.5}
+	for i, result := range results {
+		body := bodies[len(bodies)-1-i]
+		dyn4go.AssertEqual(t, body, result.GetBody())
+		dyn4go.AssertEqual(t, body.GetBodyFixture(0), result.GetFixture())
+		dyn4go.AssertEqualWithinError(t, distances[i], result.GetDistance(), 1.0e-6)
+		dyn4go.AssertEqualWithinError(t, distances[i], result.GetPoint().X, 1.0e-6)
+		dyn4go.AssertEqualWithinError(t, 0.0, result.GetPoint().Y, 1.0e-6)
+		dyn4go.AssertEqualWithinError(t, -1.0, result.GetNormal().X, 1.0e-6)
+		dyn4go.AssertEqualWithinError(t, 0.0, result.GetNormal().Y, 1.0e-6)
+	}
+
+	results = w.Raycast(ray, 5.0, nil)
+	dyn4go.AssertEqual(t, 2, len(results))
+
+	ray = geometry.NewRayFromVector2Vector2(geometry.NewVector2FromXY(0.0, 2.0), geometry.NewVector2FromXY(1.0, 0.0))
+	dyn4go.AssertEqual(t, 0, len(w.Raycast(ray, 0, nil)))
+}
+
+/**
+ * Tests the closest and any raycast modes.
+ */
+func TestWorldRaycastClosestAny(t *testing.T) {
+	w, bodies := createWorldTestRaycast()
+	ray := geometry.NewRayFromVector2Vector2(geometry.NewVector2FromXY(10.0, 0.0), geometry.NewVector2FromXY(-1.0, 0.0))
+	results := w.RaycastMode(ray, 0, nil, RAYCAST_MODE_CLOSEST)
+	dyn4go.AssertEqual(t, 1, len(results))
+	dyn4go.AssertEqual(t, bodies[0], results[0].GetBody())
+	dyn4go.AssertEqualWithinError(t, 1.5, results[0].GetDistance(), 1.0e-6)
+	dyn4go.AssertEqualWithinError(t, 1.0, results[0].GetNormal().X, 1.0e-6)
+
+	results = w.RaycastMode(ray, 0, nil, RAYCAST_MODE_ANY)
+	dyn4go.AssertEqual(t, 1, len(results))
+
+	dyn4go.AssertEqual(t, 0, len(w.RaycastMode(ray, 1.0, nil, RAYCAST_MODE_CLOSEST)))
+	dyn4go.AssertEqual(t, 0, len(w.RaycastMode(ray, 1.0, nil, RAYCAST_MODE_ANY)))
+}
+
+/**
+ * Tests that world raycasts skip filtered and sensor fixtures.
+ */
+func TestWorldRaycastFilterSensor(t *testing.T) {
+	w, bodies := createWorldTestRaycast()
+	ray := geometry.NewRayFromVector2Vector2(new(geometry.Vector2), geometry.NewVector2FromXY(1.0, 0.0))
+	filter := new(worldTestExcludeFilter)
+	filter.excluded = new(worldTestFilter)
+	bodies[3].GetBodyFixture(0).SetFilter(filter.excluded)
+	bodies[1].GetBodyFixture(0).SetSensor(true)
+	results := w.RaycastMode(ray, 0, filter, RAYCAST_MODE_CLOSEST)
+	dyn4go.AssertEqual(t, 1, len(results))
+	dyn4go.AssertEqual(t, bodies[2], results[0].GetBody())
+	dyn4go.AssertEqual(t, 2, len(w.Raycast(ray, 0, filter)))
+	dyn4go.AssertEqual(t, 3, len(w.Raycast(ray, 0, nil)))
+}
+
+/**
+ * Tests raycasting with an invalid mode.
+ */
+func TestWorldRaycastModeInvalid(t *testing.T) {
+	defer dyn4go.AssertPanic(t)
+	w, _ := createWorldTestRaycast()
+	w.RaycastMode(geometry.NewRayFromFloat(0.0), 0, nil, 3)
+}
+
+/**
+ * Tests that a convex cast finds the first fixture along the sweep.
+ */
+func TestWorldConvexCast(t *testing.T) {
+	w, bodies := createWorldTestRaycast()
+	circle := geometry.CreateCircle(0.25)
+	tx := geometry.NewTransform()
+	dp := geometry.NewVector2FromXY(10.0, 0.0)
+	result := w.ConvexCast(circle, tx, dp, 0, nil)
+	dyn4go.AssertTrue(t, result != nil)
+	dyn4go.AssertEqual(t, bodies[3], result.GetBody())
+	dyn4go.AssertEqual(t, bodies[3].GetBodyFixture(0), result.GetFixture())
+	dyn4go.AssertEqualWithinError(t, 0.125, result.GetTime(), 1.0e-4)
+	dyn4go.AssertEqualWithinError(t, 1.5, result.GetPoint().X, 1.0e-4)
+	dyn4go.AssertEqualWithinError(t, -1.0, result.GetNormal().X, 1.0e-4)
+	dyn4go.AssertEqualWithinError(t, 0.0, result.GetNormal().Y, 1.0e-4)
+
+	filter := new(worldTestExcludeFilter)
+	filter.excluded = new(worldTestFilter)
+	bodies[3].GetBodyFixture(0).SetFilter(filter.excluded)
+	bodies[2].GetBodyFixture(0).SetSensor(true)
+	result = w.ConvexCast(geometry.CreateSquare(0.5), tx, dp, 1.0, filter)
+	dyn4go.AssertTrue(t, result != nil)
+	dyn4go.AssertEqual(t, bodies[1], result.GetBody())
+	dyn4go.AssertTrue(t, result.GetTime() > 0.5)
+	dyn4go.AssertTrue(t, result.GetTime() < 0.55)
+
+	dyn4go.AssertTrue(t, w.ConvexCast(circle, tx, geometry.NewVector2FromXY(0.0, 10.0), 0, nil) == nil)
+	dyn4go.AssertTrue(t, w.ConvexCast(circle, tx, geometry.NewVector2FromXY(1.0, 0.0), 0, nil) == nil)
+}
+
+/**
+ * Tests point and AABB overlap queries.
+ */
+func TestWorldDetectPointAABB(t *testing.T) {
+	w, bodies := createWorldTestRaycast()
+	results := w.DetectPoint(geometry.NewVector2FromXY(8.4, 0.4), nil)
+	dyn4go.AssertEqual(t, 1, len(results))
+	dyn4go.AssertEqual(t, bodies[0], results[0].GetBody())
+	dyn4go.AssertEqual(t, bodies[0].GetBodyFixture(0), results[0].GetFixture())
+	dyn4go.AssertEqual(t, 0, len(w.DetectPoint(geometry.NewVector2FromXY(6.45, 0.45), nil)))
+
+	dyn4go.AssertEqual(t, 0, len(w.DetectAABB(geometry.NewAABBFromFloats(5.55, 0.45, 5.6, 0.5), nil)))
+	dyn4go.AssertEqual(t, 3, len(w.DetectAABB(geometry.NewAABBFromFloats(3.5, -0.5, 8.5, 0.5), nil)))
+	results = w.DetectAABB(geometry.NewAABBFromFloats(3.5, 0.0, 4.5, 0.0), nil)
+	dyn4go.AssertEqual(t, 1, len(results))
+	dyn4go.AssertEqual(t, bodies[2], results[0].GetBody())
+	results = w.DetectAABB(geometry.NewAABBFromFloats(2.0, 0.0, 2.0, 0.0), nil)
+	dyn4go.AssertEqual(t, 1, len(results))
+	dyn4go.AssertEqual(t, bodies[3], results[0].GetBody())
+}
+
+/**
+ * Tests convex overlap queries with filters and sensors.
+ */
+func TestWorldDetectConvex(t *testing.T) {
+	w, bodies := createWorldTestRaycast()
+	circle := geometry.CreateCircle(1.0)
+	tx := geometry.NewTransform()
+	tx.TranslateXY(7.0, 0.0)
+	results := w.DetectConvex(circle, tx, nil)
+	dyn4go.AssertEqual(t, 2, len(results))
+	for _, result := range results {
+		dyn4go.AssertTrue(t, result.GetBody() == bodies[0] || result.GetBody() == bodies[1])
+	}
+
+	filter := new(worldTestExcludeFilter)
+	filter.excluded = new(worldTestFilter)
+	bodies[0].GetBodyFixture(0).SetFilter(filter.excluded)
+	results = w.DetectConvex(circle, tx, filter)
+	dyn4go.AssertEqual(t, 1, len(results))
+	dyn4go.AssertEqual(t, bodies[1], results[0].GetBody())
+
+	bodies[1].GetBodyFixture(0).SetSensor(true)
+	dyn4go.AssertEqual(t, 0, len(w.DetectConvex(circle, tx, filter)))
+	dyn4go.AssertEqual(t, 1, len(w.DetectConvex(circle, tx, nil)))
+}
+
+type worldTestNoopPostProcessor struct{}
+
+func (p *worldTestNoopPostProcessor) Process(convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform, penetration *narrowphase.Penetration) bool {
+	return true
+}
+
+func (p *worldTestNoopPostProcessor) ProcessSeparation(convex1 geometry.Convexer, transform1 *geometry.Transform, convex2 geometry.Convexer, transform2 *geometry.Transform, separation *narrowphase.Separation) bool {
+	return true
+}
+
+func createWorldTestChain() (*World, *Body) {
+	w := NewWorld()
+	vertices := make([]*geometry.Vector2, 21)
+	for i := range vertices {
+		vertices[i] = geometry.NewVector2FromXY(-10.0+float64(i), 0.0)
+	}
+	floor := NewBody()
+	for _, link := range geometry.CreateChain(vertices...) {
+		floor.AddFixtureConvex(link).SetFriction(1.0e-6)
+	}
+	floor.UpdateMassWithType(geometry.INFINITE)
+	w.AddBody(floor)
+	box := createWorldTestBody(geometry.CreateSquare(1.0), geometry.NORMAL)
+	box.GetBodyFixture(0).SetFriction(1.0e-6)
+	box.TranslateXY(-8.0, 0.5)
+	box.SetVelocity(geometry.NewVector2FromXY(4.0, 0.0))
+	w.AddBody(box)
+	return w, box
+}
+
+/**
+ * Tests that a box sliding over a chain of links does not catch on the
+ * internal vertices.
+ */
+func TestWorldChainSmoothSliding(t *testing.T) {
+	for _, mode := range []int{CONTINUOUS_DETECTION_MODE_ALL, CONTINUOUS_DETECTION_MODE_SPECULATIVE} {
+		w, box := createWorldTestChain()
+		w.GetSettings().SetContinuousDetectionMode(mode)
+		for i := 0; i < 180; i++ {
+			w.Step(DEFAULT_STEP_FREQUENCY)
+			for _, constraint := range w.GetContactConstraints() {
+				dyn4go.AssertTrue(t, math.Abs(constraint.GetNormal().X) < 1.0e-9)
+			}
+		}
+		dyn4go.AssertTrue(t, box.GetWorldCenter().X > 3.5)
+		dyn4go.AssertEqualWithinError(t, 4.0, box.GetVelocity().X, 1.0e-3)
+		dyn4go.AssertTrue(t, math.Abs(box.GetWorldCenter().Y-0.5) < 1.0e-2)
+		dyn4go.AssertTrue(t, math.Abs(box.GetTransform().GetRotation()) < 1.0e-2)
+	}
+}
+
+/**
+ * Tests setting a nil narrowphase post processor.
+ */
+func TestWorldNarrowphasePostProcessorNil(t *testing.T) {
+	w := NewWorld()
+	w.SetNarrowphasePostProcessor(new(worldTestNoopPostProcessor))
+	defer dyn4go.AssertPanic(t)
+	w.SetNarrowphasePostProcessor(nil)
+}
@@ -0,0 +1,40 @@
+package geometry
+
+func CreateChain(vertices ...*Vector2) []*Link {
+	if len(vertices) < 2 {
+		panic("A chain requires at least 2 vertices")
+	}
+	return createChain(vertices, false)
+}
+
+func CreateChainLoop(vertices ...*Vector2) []*Link {
+	if len(vertices) < 3 {
+		panic("A chain loop requires at least 3 vertices")
+	}
+	return createChain(vertices, true)
+}
+
+func createChain(vertices []*Vector2, closed bool) []*Link {
+	for _, v := range vertices {
+		if v == nil {
+			panic("Cannot create chain from nil vertices")
+		}
+	}
+	n := len(vertices)
+	count := n - 1
+	if closed {
+		count = n
+	}
+	links := make([]*Link, count)
+	for i := 0; i < count; i++ {
+		var point0, point3 *Vector2
+		if i > 0 || closed {
+			point0 = NewVector2FromVector2(vertices[(i+n-1)%n])
+		}
+		if i < count-1 || closed {
+			point3 = NewVector2FromVector2(vertices[(i+2)%n])
+		}
+		links[i] = NewLink(point0, NewVector2FromVector2(vertices[i]), NewVector2FromVector2(vertices[(i+1)%n]), point3)
+	}
+	return links
+}
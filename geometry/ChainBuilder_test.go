@@ -0,0 +1,81 @@
+package geometry
+
+import (
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+)
+
+/**
+ * Tests creating an open chain of links.
+ */
+func TestChainBuilderCreateChain(t *testing.T) {
+	vertices := []*Vector2{
+		NewVector2FromXY(0.0, 0.0),
+		NewVector2FromXY(1.0, 0.0),
+		NewVector2FromXY(2.0, 0.5),
+		NewVector2FromXY(3.0, 0.5),
+	}
+	links := CreateChain(vertices...)
+	dyn4go.AssertEqual(t, 3, len(links))
+	dyn4go.AssertTrue(t, links[0].GetPoint0() == nil)
+	dyn4go.AssertTrue(t, links[2].GetPoint3() == nil)
+	for i, l := range links {
+		dyn4go.AssertTrue(t, l.GetPoint1().EqualsVector2(vertices[i]))
+		dyn4go.AssertTrue(t, l.GetPoint2().EqualsVector2(vertices[i+1]))
+		dyn4go.AssertFalse(t, l.GetPoint1() == vertices[i])
+		if i > 0 {
+			dyn4go.AssertTrue(t, l.GetPoint0().EqualsVector2(vertices[i-1]))
+		}
+		if i < 2 {
+			dyn4go.AssertTrue(t, l.GetPoint3().EqualsVector2(vertices[i+2]))
+		}
+	}
+
+	links = CreateChain(vertices[0], vertices[1])
+	dyn4go.AssertEqual(t, 1, len(links))
+	dyn4go.AssertTrue(t, links[0].GetPoint0() == nil)
+	dyn4go.AssertTrue(t, links[0].GetPoint3() == nil)
+}
+
+/**
+ * Tests creating a closed loop of links.
+ */
+func TestChainBuilderCreateChainLoop(t *testing.T) {
+	vertices := []*Vector2{
+		NewVector2FromXY(0.0, 0.0),
+		NewVector2FromXY(1.0, 0.0),
+		NewVector2FromXY(1.0, 1.0),
+		NewVector2FromXY(0.0, 1.0),
+	}
+	links := CreateChainLoop(vertices...)
+	dyn4go.AssertEqual(t, 4, len(links))
+	for i, l := range links {
+		dyn4go.AssertTrue(t, l.GetPoint0().EqualsVector2(vertices[(i+3)%4]))
+		dyn4go.AssertTrue(t, l.GetPoint1().EqualsVector2(vertices[i]))
+		dyn4go.AssertTrue(t, l.GetPoint2().EqualsVector2(vertices[(i+1)%4]))
+		dyn4go.AssertTrue(t, l.GetPoint3().EqualsVector2(vertices[(i+2)%4]))
+	}
+}
+
+/**
+ * Tests creating chains from invalid vertices.
+ */
+func TestChainBuilderInvalid(t *testing.T) {
+	func() {
+		defer dyn4go.AssertPanic(t)
+		CreateChain(new(Vector2))
+	}()
+	func() {
+		defer dyn4go.AssertPanic(t)
+		CreateChainLoop(new(Vector2), NewVector2FromXY(1.0, 0.0))
+	}()
+	func() {
+		defer dyn4go.AssertPanic(t)
+		CreateChain(new(Vector2), nil)
+	}()
+	func() {
+		defer dyn4go.AssertPanic(t)
+		CreateChain(new(Vector2), NewVector2FromXY(1.0, 0.0), NewVector2FromXY(1.0, 0.0))
+	}()
+}
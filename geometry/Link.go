@@ -0,0 +1,114 @@
+package geometry
+
+type Link struct {
+	Segment
+	point0, point3 *Vector2
+}
+
+func NewLink(point0, point1, point2, point3 *Vector2) *Link {
+	l := new(Link)
+	l.Segment = *NewSegment(point1, point2)
+	l.SetPoint0(point0)
+	l.SetPoint3(point3)
+	return l
+}
+
+func (l *Link) GetPoint0() *Vector2 {
+	return l.point0
+}
+
+func (l *Link) SetPoint0(point0 *Vector2) {
+	if point0 != nil && *point0 == *l.vertices[0] {
+		panic("The previous ghost vertex must not be equivalent to the first point")
+	}
+	l.point0 = point0
+}
+
+func (l *Link) GetPoint3() *Vector2 {
+	return l.point3
+}
+
+func (l *Link) SetPoint3(point3 *Vector2) {
+	if point3 != nil && *point3 == *l.vertices[1] {
+		panic("The next ghost vertex must not be equivalent to the second point")
+	}
+	l.point3 = point3
+}
+
+func (l *Link) GetCorrectedNormal(n *Vector2, transform *Transform) *Vector2 {
+	ln := transform.GetInverseTransformedR(n)
+	ln.Normalize()
+	p1 := l.vertices[0]
+	p2 := l.vertices[1]
+	e := p1.HereToVector2(p2)
+	e.Normalize()
+	normal := e.GetRightHandOrthogonalVector()
+	sign := 1.0
+	if normal.DotVector2(ln) < 0 {
+		normal.Negate()
+		sign = -1.0
+	}
+	corrected := normal
+	if d := e.DotVector2(ln); d < 0 {
+		corrected = getLinkVertexNormal(ln, normal, l.point0, p1, sign, e.GetNegative())
+	} else if d > 0 {
+		corrected = getLinkVertexNormal(ln, normal, p2, l.point3, sign, e)
+	}
+	return transform.GetTransformedR(corrected)
+}
+
+func getLinkVertexNormal(n, normal, from, to *Vector2, sign float64, out *Vector2) *Vector2 {
+	if from == nil || to == nil {
+		return n
+	}
+	edge := from.HereToVector2(to)
+	edge.Normalize()
+	adjacent := edge.GetRightHandOrthogonalVector().Multiply(sign)
+	if adjacent.DotVector2(out) <= 0 {
+		return normal
+	}
+	if n.DotVector2(normal) >= adjacent.DotVector2(normal) {
+		return n
+	}
+	return adjacent
+}
+
+func (l *Link) GetFarthestFeature(n *Vector2, transform *Transform) Featurer {
+	return GetFarthestFeature(l.vertices[0], l.vertices[1], l.GetCorrectedNormal(n, transform), transform)
+}
+
+func (l *Link) RotateAboutXY(theta, x, y float64) {
+	l.Segment.RotateAboutXY(theta, x, y)
+	if l.point0 != nil {
+		l.point0.RotateAboutXY(theta, x, y)
+	}
+	if l.point3 != nil {
+		l.point3.RotateAboutXY(theta, x, y)
+	}
+}
+
+func (l *Link) TranslateXY(x, y float64) {
+	l.Segment.TranslateXY(x, y)
+	if l.point0 != nil {
+		l.point0.AddXY(x, y)
+	}
+	if l.point3 != nil {
+		l.point3.AddXY(x, y)
+	}
+}
+
+func (l *Link) RotateAboutOrigin(theta float64) {
+	l.RotateAboutXY(theta, 0, 0)
+}
+
+func (l *Link) RotateAboutCenter(theta float64) {
+	l.RotateAboutXY(theta, l.center.X, l.center.Y)
+}
+
+func (l *Link) RotateAboutVector2(theta float64, v *Vector2) {
+	l.RotateAboutXY(theta, v.X, v.Y)
+}
+
+func (l *Link) TranslateVector2(v *Vector2) {
+	l.TranslateXY(v.X, v.Y)
+}
@@ -0,0 +1,147 @@
+package geometry
+
+import (
+	"math"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+)
+
+func TestLinkInterfaces(t *testing.T) {
+	l := NewLink(nil, NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), nil)
+	var _ Convexer = l
+	var _ Wounder = l
+}
+
+/**
+ * Tests creating a link with ghost vertices equal to its points.
+ */
+func TestLinkCreateCoincidentGhost(t *testing.T) {
+	func() {
+		defer dyn4go.AssertPanic(t)
+		NewLink(NewVector2FromXY(0.0, 0.0), NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), nil)
+	}()
+	func() {
+		defer dyn4go.AssertPanic(t)
+		NewLink(nil, NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), NewVector2FromXY(1.0, 0.0))
+	}()
+	func() {
+		defer dyn4go.AssertPanic(t)
+		NewLink(nil, nil, NewVector2FromXY(1.0, 0.0), nil)
+	}()
+}
+
+/**
+ * Tests that normals at an internal vertex of a flat chain are corrected to the face normal.
+ */
+func TestLinkCorrectedNormalFlat(t *testing.T) {
+	l := NewLink(NewVector2FromXY(-1.0, 0.0), NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), NewVector2FromXY(2.0, 0.0))
+	tx := NewTransform()
+
+	n := l.GetCorrectedNormal(NewVector2FromXY(1.0, 0.0), tx)
+	dyn4go.AssertEqualWithinError(t, 0.0, n.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.0, math.Abs(n.Y), 1.0e-9)
+
+	n = l.GetCorrectedNormal(NewVector2FromXY(-1.0, 1.0), tx)
+	dyn4go.AssertEqualWithinError(t, 0.0, n.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.0, n.Y, 1.0e-9)
+
+	n = l.GetCorrectedNormal(NewVector2FromXY(1.0, -1.0), tx)
+	dyn4go.AssertEqualWithinError(t, 0.0, n.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, -1.0, n.Y, 1.0e-9)
+
+	tx.RotateAboutOrigin(math.Pi * 0.5)
+	n = l.GetCorrectedNormal(NewVector2FromXY(-1.0, 1.0), tx)
+	dyn4go.AssertEqualWithinError(t, -1.0, n.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 0.0, n.Y, 1.0e-9)
+}
+
+/**
+ * Tests that normals at a free end of a link are unchanged.
+ */
+func TestLinkCorrectedNormalFree(t *testing.T) {
+	l := NewLink(nil, NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), nil)
+	n := l.GetCorrectedNormal(NewVector2FromXY(-1.0, 1.0), NewTransform())
+	dyn4go.AssertEqualWithinError(t, -math.Sqrt2*0.5, n.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, math.Sqrt2*0.5, n.Y, 1.0e-9)
+}
+
+/**
+ * Tests correcting normals at convex and concave internal vertices.
+ */
+func TestLinkCorrectedNormalConvexConcave(t *testing.T) {
+	tx := NewTransform()
+	// the outside of a convex vertex allows normals between the adjacent face normals
+	hill := NewLink(NewVector2FromXY(-1.0, -1.0), NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), nil)
+	n := hill.GetCorrectedNormal(NewVector2FromXY(-0.2, 1.0), tx)
+	dyn4go.AssertTrue(t, n.X < 0)
+	n = hill.GetCorrectedNormal(NewVector2FromXY(-1.0, 0.2), tx)
+	dyn4go.AssertEqualWithinError(t, -math.Sqrt2*0.5, n.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, math.Sqrt2*0.5, n.Y, 1.0e-9)
+
+	// the inside of the same vertex is concave
+	n = hill.GetCorrectedNormal(NewVector2FromXY(-0.2, -1.0), tx)
+	dyn4go.AssertEqualWithinError(t, 0.0, n.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, -1.0, n.Y, 1.0e-9)
+
+	valley := NewLink(nil, NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), NewVector2FromXY(2.0, 1.0))
+	n = valley.GetCorrectedNormal(NewVector2FromXY(0.2, 1.0), tx)
+	dyn4go.AssertEqualWithinError(t, 0.0, n.X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.0, n.Y, 1.0e-9)
+}
+
+/**
+ * Tests that the farthest feature of a link uses the corrected normal.
+ */
+func TestLinkGetFarthestFeature(t *testing.T) {
+	l := NewLink(NewVector2FromXY(-1.0, 0.0), NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), NewVector2FromXY(2.0, 0.0))
+	tx := NewTransform()
+	f := l.GetFarthestFeature(NewVector2FromXY(1.0, 0.01), tx)
+	e, ok := f.(*Edge)
+	dyn4go.AssertTrue(t, ok)
+	dyn4go.AssertEqualWithinError(t, 0.0, e.GetEdge().GetNormalized().CrossVector2(NewVector2FromXY(1.0, 0.0)), 1.0e-9)
+
+	s := NewSegment(NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0))
+	f = s.GetFarthestFeature(NewVector2FromXY(1.0, 0.01), tx)
+	dyn4go.AssertEqual(t, 1, f.(*Edge).GetMaximum().GetIndex())
+	f = l.GetFarthestFeature(NewVector2FromXY(1.0, 0.01), tx)
+	dyn4go.AssertEqual(t, 0, f.(*Edge).GetMaximum().GetIndex())
+}
+
+/**
+ * Tests the separating axes of a link against foci.
+ */
+func TestLinkGetAxes(t *testing.T) {
+	l := NewLink(NewVector2FromXY(-1.0, 0.0), NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), nil)
+	tx := NewTransform()
+	axes := l.GetAxes([]*Vector2{NewVector2FromXY(-1.0, 1.0), NewVector2FromXY(2.0, 1.0)}, tx)
+	dyn4go.AssertEqual(t, 4, len(axes))
+	dyn4go.AssertEqualWithinError(t, -math.Sqrt2*0.5, axes[2].X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, math.Sqrt2*0.5, axes[2].Y, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, math.Sqrt2*0.5, axes[3].X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, math.Sqrt2*0.5, axes[3].Y, 1.0e-9)
+}
+
+/**
+ * Tests that rotating and translating a link moves its ghost vertices.
+ */
+func TestLinkRotateTranslate(t *testing.T) {
+	l := NewLink(NewVector2FromXY(-1.0, 0.0), NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0), NewVector2FromXY(2.0, 0.0))
+	l.TranslateXY(1.0, 2.0)
+	dyn4go.AssertEqualWithinError(t, 0.0, l.GetPoint0().X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 2.0, l.GetPoint0().Y, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 3.0, l.GetPoint3().X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 2.0, l.GetPoint3().Y, 1.0e-9)
+
+	l.RotateAboutCenter(math.Pi * 0.5)
+	dyn4go.AssertEqualWithinError(t, 1.5, l.GetPoint0().X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 0.5, l.GetPoint0().Y, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.5, l.GetPoint3().X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 3.5, l.GetPoint3().Y, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.5, l.GetPoint1().X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, 1.5, l.GetPoint1().Y, 1.0e-9)
+
+	l.TranslateVector2(NewVector2FromXY(-1.5, -1.5))
+	dyn4go.AssertEqualWithinError(t, 0.0, l.GetPoint0().X, 1.0e-9)
+	dyn4go.AssertEqualWithinError(t, -1.0, l.GetPoint0().Y, 1.0e-9)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/LSFN/dyn4go"
 )
 
+var orientationEpsilon = math.Sqrt(dyn4go.Epsilon)
+
 type Segment struct {
 	Wound
 	length float64
@@ -129,6 +131,39 @@ func (s *Segment) GetSegmentIntersection(segment *Segment) *Vector2 {
 	return GetSegmentIntersection(s.vertices[0], s.vertices[1], segment.vertices[0], segment.vertices[1])
 }
 
+// GetOrientation returns the cross product of oa and ob, snapped to zero when
+// the angle between them is within orientationEpsilon of collinear.
+func GetOrientation(o, a, b *Vector2) float64 {
+	ax, ay := a.X-o.X, a.Y-o.Y
+	bx, by := b.X-o.X, b.Y-o.Y
+	cross := ax*by - ay*bx
+	if math.Abs(cross) <= orientationEpsilon*math.Sqrt((ax*ax+ay*ay)*(bx*bx+by*by)) {
+		return 0
+	}
+	return cross
+}
+
+// IsSegmentIntersecting returns true if the segments a1a2 and b1b2 cross or touch.
+func IsSegmentIntersecting(a1, a2, b1, b2 *Vector2) bool {
+	o1 := GetOrientation(a1, a2, b1)
+	o2 := GetOrientation(a1, a2, b2)
+	o3 := GetOrientation(b1, b2, a1)
+	o4 := GetOrientation(b1, b2, a2)
+	if ((o1 > 0 && o2 < 0) || (o1 < 0 && o2 > 0)) && ((o3 > 0 && o4 < 0) || (o3 < 0 && o4 > 0)) {
+		return true
+	}
+	return (o1 == 0 && isOnSegment(a1, a2, b1)) ||
+		(o2 == 0 && isOnSegment(a1, a2, b2)) ||
+		(o3 == 0 && isOnSegment(b1, b2, a1)) ||
+		(o4 == 0 && isOnSegment(b1, b2, a2))
+}
+
+func isOnSegment(a, b, p *Vector2) bool {
+	ab := a.HereToVector2(b)
+	d := a.HereToVector2(p).DotVector2(ab)
+	return d >= 0 && d <= ab.GetMagnitudeSquared()
+}
+
 func GetFarthestFeature(v1, v2, n *Vector2, t *Transform) Featurer {
 	p1 := t.GetTransformedVector2(v1)
 	p2 := t.GetTransformedVector2(v2)
@@ -586,3 +586,36 @@ func TestSegmentGetSegmentIntersection(t *testing.T) {
 		t.Error("Value is not nil in assertion")
 	}
 }
+
+/**
+ * Tests the orientation of three points, including nearly collinear points.
+ */
+func TestSegmentGetOrientation(t *testing.T) {
+	o := NewVector2FromXY(0.0, 0.0)
+	a := NewVector2FromXY(1.0, 0.0)
+	dyn4go.AssertTrue(t, GetOrientation(o, a, NewVector2FromXY(1.0, 1.0)) > 0)
+	dyn4go.AssertTrue(t, GetOrientation(o, a, NewVector2FromXY(1.0, -1.0)) < 0)
+	dyn4go.AssertEqual(t, 0.0, GetOrientation(o, a, NewVector2FromXY(2.0, 0.0)))
+	dyn4go.AssertEqual(t, 0.0, GetOrientation(o, a, NewVector2FromXY(2.0, 1.0e-12)))
+}
+
+/**
+ * Tests the segment intersection predicate for crossing, touching and disjoint segments.
+ */
+func TestSegmentIsSegmentIntersecting(t *testing.T) {
+	dyn4go.AssertTrue(t, IsSegmentIntersecting(
+		NewVector2FromXY(-1.0, 0.0), NewVector2FromXY(1.0, 0.0),
+		NewVector2FromXY(0.0, -1.0), NewVector2FromXY(0.0, 1.0)))
+	dyn4go.AssertTrue(t, IsSegmentIntersecting(
+		NewVector2FromXY(-1.0, 0.0), NewVector2FromXY(1.0, 0.0),
+		NewVector2FromXY(1.0, 0.0), NewVector2FromXY(2.0, 1.0)))
+	dyn4go.AssertTrue(t, IsSegmentIntersecting(
+		NewVector2FromXY(0.0, 0.0), NewVector2FromXY(2.0, 0.0),
+		NewVector2FromXY(1.0, 1.0e-12), NewVector2FromXY(3.0, 1.0e-12)))
+	dyn4go.AssertFalse(t, IsSegmentIntersecting(
+		NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0),
+		NewVector2FromXY(2.0, 0.0), NewVector2FromXY(3.0, 0.0)))
+	dyn4go.AssertFalse(t, IsSegmentIntersecting(
+		NewVector2FromXY(0.0, 0.0), NewVector2FromXY(1.0, 0.0),
+		NewVector2FromXY(0.0, 1.0), NewVector2FromXY(1.0, 1.0)))
+}
@@ -0,0 +1,181 @@
+package decompose
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type Bayazit struct{}
+
+var _ Decomposer = new(Bayazit)
+
+func (b *Bayazit) Decompose(points []*geometry.Vector2) ([]geometry.Convexer, error) {
+	polygon, err := getSimplePolygon(points)
+	if err != nil {
+		return nil, err
+	}
+	pieces := make([][]*geometry.Vector2, 0)
+	splits := 4 * len(polygon)
+	if err := b.decompose(polygon, &pieces, &splits); err != nil {
+		return nil, err
+	}
+	convexes := make([]geometry.Convexer, 0, len(pieces))
+	for _, piece := range pieces {
+		convex, err := createConvex(piece)
+		if err != nil {
+			return nil, err
+		}
+		if convex != nil {
+			convexes = append(convexes, convex)
+		}
+	}
+	return convexes, nil
+}
+
+func at(polygon []*geometry.Vector2, i int) *geometry.Vector2 {
+	n := len(polygon)
+	return polygon[((i%n)+n)%n]
+}
+
+func (b *Bayazit) decompose(polygon []*geometry.Vector2, pieces *[][]*geometry.Vector2, splits *int) error {
+	n := len(polygon)
+	for i := 0; i < n; i++ {
+		prev := at(polygon, i-1)
+		p := polygon[i]
+		next := at(polygon, i+1)
+		if geometry.GetOrientation(prev, p, next) >= 0 {
+			continue
+		}
+		*splits--
+		if *splits < 0 {
+			return ErrNumerical
+		}
+
+		upperDistance := math.Inf(1)
+		lowerDistance := math.Inf(1)
+		var upperIntersection, lowerIntersection *geometry.Vector2
+		upperIndex, lowerIndex := -1, -1
+		for j := 0; j < n; j++ {
+			if geometry.GetOrientation(prev, p, at(polygon, j)) > 0 && geometry.GetOrientation(prev, p, at(polygon, j-1)) <= 0 {
+				q := getLineIntersection(prev, p, at(polygon, j), at(polygon, j-1))
+				if q != nil && geometry.GetOrientation(next, p, q) < 0 {
+					d := p.DistanceSquaredFromVector2(q)
+					if d < lowerDistance {
+						lowerDistance = d
+						lowerIntersection = q
+						lowerIndex = j
+					}
+				}
+			}
+			if geometry.GetOrientation(next, p, at(polygon, j+1)) > 0 && geometry.GetOrientation(next, p, at(polygon, j)) <= 0 {
+				q := getLineIntersection(next, p, at(polygon, j), at(polygon, j+1))
+				if q != nil && geometry.GetOrientation(prev, p, q) > 0 {
+					d := p.DistanceSquaredFromVector2(q)
+					if d < upperDistance {
+						upperDistance = d
+						upperIntersection = q
+						upperIndex = j
+					}
+				}
+			}
+		}
+		if lowerIndex < 0 || upperIndex < 0 {
+			return ErrNumerical
+		}
+
+		var lower, upper []*geometry.Vector2
+		if lowerIndex == (upperIndex+1)%n {
+			steiner := lowerIntersection.SumVector2(upperIntersection).Multiply(0.5)
+			if i < upperIndex {
+				lower = append(lower, polygon[i:upperIndex+1]...)
+				lower = append(lower, steiner)
+				upper = append(upper, steiner)
+				if lowerIndex != 0 {
+					upper = append(upper, polygon[lowerIndex:]...)
+				}
+				upper = append(upper, polygon[:i+1]...)
+			} else {
+				if i != 0 {
+					lower = append(lower, polygon[i:]...)
+				}
+				lower = append(lower, polygon[:upperIndex+1]...)
+				lower = append(lower, steiner)
+				upper = append(upper, steiner)
+				upper = append(upper, polygon[lowerIndex:i+1]...)
+			}
+		} else {
+			if lowerIndex > upperIndex {
+				upperIndex += n
+			}
+			closestDistance := math.Inf(1)
+			closestIndex := -1
+			for j := lowerIndex; j <= upperIndex; j++ {
+				q := at(polygon, j)
+				if geometry.GetOrientation(prev, p, q) >= 0 && geometry.GetOrientation(next, p, q) <= 0 && b.isVisible(polygon, i, j%n) {
+					d := p.DistanceSquaredFromVector2(q)
+					if d < closestDistance {
+						closestDistance = d
+						closestIndex = j % n
+					}
+				}
+			}
+			if closestIndex < 0 {
+				return ErrNumerical
+			}
+			if i < closestIndex {
+				lower = append(lower, polygon[i:closestIndex+1]...)
+				if closestIndex != 0 {
+					upper = append(upper, polygon[closestIndex:]...)
+				}
+				upper = append(upper, polygon[:i+1]...)
+			} else {
+				if i != 0 {
+					lower = append(lower, polygon[i:]...)
+				}
+				lower = append(lower, polygon[:closestIndex+1]...)
+				upper = append(upper, polygon[closestIndex:i+1]...)
+			}
+		}
+		if len(lower) < 3 || len(upper) < 3 {
+			return ErrNumerical
+		}
+		if len(lower) > len(upper) {
+			lower, upper = upper, lower
+		}
+		if err := b.decompose(lower, pieces, splits); err != nil {
+			return err
+		}
+		return b.decompose(upper, pieces, splits)
+	}
+	*pieces = append(*pieces, polygon)
+	return nil
+}
+
+func (b *Bayazit) isVisible(polygon []*geometry.Vector2, i, j int) bool {
+	n := len(polygon)
+	if j == i || j == (i+1)%n || j == (i+n-1)%n {
+		return false
+	}
+	for k := 0; k < n; k++ {
+		k1 := (k + 1) % n
+		if k == i || k1 == i || k == j || k1 == j {
+			continue
+		}
+		if geometry.IsSegmentIntersecting(polygon[i], polygon[j], polygon[k], polygon[k1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func getLineIntersection(a1, a2, b1, b2 *geometry.Vector2) *geometry.Vector2 {
+	a := a1.HereToVector2(a2)
+	b := b1.HereToVector2(b2)
+	d := a.CrossVector2(b)
+	if d == 0 {
+		return nil
+	}
+	t := a1.HereToVector2(b1).CrossVector2(b) / d
+	return a.Multiply(t).AddVector2(a1)
+}
@@ -0,0 +1,72 @@
+package decompose
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+var (
+	ErrTooFewVertices   = errors.New("decompose: polygon needs at least 3 distinct, non-collinear vertices")
+	ErrSelfIntersecting = errors.New("decompose: polygon is not simple")
+	ErrNumerical        = errors.New("decompose: decomposition failed due to numerical error")
+)
+
+type Decomposer interface {
+	Decompose(points []*geometry.Vector2) ([]geometry.Convexer, error)
+}
+
+func getSimplePolygon(points []*geometry.Vector2) ([]*geometry.Vector2, error) {
+	if points == nil {
+		panic("Cannot decompose nil points")
+	}
+	polygon := make([]*geometry.Vector2, 0, len(points))
+	for _, p := range points {
+		if p == nil {
+			panic("Cannot decompose nil points")
+		}
+		if len(polygon) > 0 && *polygon[len(polygon)-1] == *p {
+			continue
+		}
+		polygon = append(polygon, geometry.NewVector2FromVector2(p))
+	}
+	for len(polygon) > 1 && *polygon[0] == *polygon[len(polygon)-1] {
+		polygon = polygon[:len(polygon)-1]
+	}
+	for removed := true; removed && len(polygon) >= 3; {
+		removed = false
+		n := len(polygon)
+		for i := 0; i < n; i++ {
+			prev := polygon[(i+n-1)%n]
+			next := polygon[(i+1)%n]
+			if geometry.GetOrientation(prev, polygon[i], next) != 0 {
+				continue
+			}
+			if prev.HereToVector2(polygon[i]).DotVector2(polygon[i].HereToVector2(next)) < 0 {
+				return nil, fmt.Errorf("%w: edges %d and %d fold back onto each other", ErrSelfIntersecting, (i+n-1)%n, i)
+			}
+			polygon = append(polygon[:i], polygon[i+1:]...)
+			removed = true
+			break
+		}
+	}
+	n := len(polygon)
+	if n < 3 {
+		return nil, ErrTooFewVertices
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue
+			}
+			if geometry.IsSegmentIntersecting(polygon[i], polygon[(i+1)%n], polygon[j], polygon[(j+1)%n]) {
+				return nil, fmt.Errorf("%w: edges %d and %d intersect", ErrSelfIntersecting, i, j)
+			}
+		}
+	}
+	if geometry.GetWindingFromList(polygon) < 0 {
+		geometry.ReverseWindingFromList(polygon)
+	}
+	return polygon, nil
+}
@@ -0,0 +1,381 @@
+package decompose
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func getDecomposerTestDecomposers() []Decomposer {
+	return []Decomposer{
+		new(Bayazit),
+		new(EarClipping),
+		new(SweepLine),
+	}
+}
+
+func createDecomposerTestPolygon(coordinates ...float64) []*geometry.Vector2 {
+	points := make([]*geometry.Vector2, len(coordinates)/2)
+	for i := range points {
+		points[i] = geometry.NewVector2FromXY(coordinates[2*i], coordinates[2*i+1])
+	}
+	return points
+}
+
+func createDecomposerTestStar(r *rand.Rand, count int) []*geometry.Vector2 {
+	points := make([]*geometry.Vector2, count)
+	for i := range points {
+		angle := 2.0 * math.Pi * (float64(i) + 0.8*r.Float64()) / float64(count)
+		radius := 1.0 + 4.0*r.Float64()
+		points[i] = geometry.NewVector2FromXY(radius*math.Cos(angle), radius*math.Sin(angle))
+	}
+	return points
+}
+
+func createDecomposerTestComb(bases ...float64) []*geometry.Vector2 {
+	return createDecomposerTestPolygon(0.0, 0.0, 6.0, 0.0, 6.0, 3.0, 5.0, 3.0, 5.0, bases[0], 4.0, bases[0], 4.0, 4.0, 3.0, 4.0, 3.0, bases[1], 2.0, bases[1], 2.0, 2.0, 1.0, 2.0, 1.0, bases[2], 0.0, bases[2])
+}
+
+func createDecomposerTestRotated(points []*geometry.Vector2, degrees float64) []*geometry.Vector2 {
+	rotated := make([]*geometry.Vector2, len(points))
+	for i, p := range points {
+		rotated[i] = geometry.NewVector2FromVector2(p)
+		rotated[i].RotateAboutOrigin(degrees * math.Pi / 180.0)
+	}
+	return rotated
+}
+
+func createDecomposerTestSimple(r *rand.Rand, count int) []*geometry.Vector2 {
+	points := make([]*geometry.Vector2, count)
+	for i := range points {
+		points[i] = geometry.NewVector2FromXY(10.0*r.Float64(), 10.0*r.Float64())
+	}
+	for untangled := false; !untangled; {
+		untangled = true
+		for i := 0; i < count; i++ {
+			for j := i + 2; j < count; j++ {
+				if i == 0 && j == count-1 {
+					continue
+				}
+				if geometry.IsSegmentIntersecting(points[i], points[i+1], points[j], points[(j+1)%count]) {
+					for a, b := i+1, j; a < b; a, b = a+1, b-1 {
+						points[a], points[b] = points[b], points[a]
+					}
+					untangled = false
+				}
+			}
+		}
+	}
+	return points
+}
+
+func getDecomposerTestArea(points []*geometry.Vector2) float64 {
+	return math.Abs(geometry.GetWindingFromList(points)) * 0.5
+}
+
+func isDecomposerTestInside(points []*geometry.Vector2, p *geometry.Vector2) bool {
+	inside := false
+	for i := range points {
+		a := points[i]
+		b := points[(i+1)%len(points)]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < a.X+(p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func assertDecomposition(t *testing.T, points []*geometry.Vector2, convexes []geometry.Convexer) {
+	area := 0.0
+	for _, convex := range convexes {
+		vertices := convex.(geometry.Wounder).GetVertices()
+		n := len(vertices)
+		dyn4go.AssertTrue(t, n >= 3)
+		for i := range vertices {
+			dyn4go.AssertTrue(t, geometry.GetOrientation(vertices[(i+n-1)%n], vertices[i], vertices[(i+1)%n]) > 0)
+		}
+		area += getDecomposerTestArea(vertices)
+		dyn4go.AssertTrue(t, isDecomposerTestInside(points, convex.GetCenter()))
+	}
+	dyn4go.AssertTrue(t, math.Abs(getDecomposerTestArea(points)-area) < 1.0e-9*math.Max(1.0, area))
+}
+
+/**
+ * Tests that a convex polygon is returned as a single piece.
+ */
+func TestDecomposeConvex(t *testing.T) {
+	square := createDecomposerTestPolygon(0.0, 0.0, 2.0, 0.0, 2.0, 2.0, 0.0, 2.0)
+	for _, decomposer := range getDecomposerTestDecomposers() {
+		convexes, err := decomposer.Decompose(square)
+		dyn4go.AssertTrue(t, err == nil)
+		dyn4go.AssertEqual(t, 1, len(convexes))
+		assertDecomposition(t, square, convexes)
+		for _, v := range convexes[0].(geometry.Wounder).GetVertices() {
+			for _, p := range square {
+				dyn4go.AssertTrue(t, v != p)
+			}
+		}
+	}
+}
+
+/**
+ * Tests decomposing concave polygons in either winding.
+ */
+func TestDecomposeConcave(t *testing.T) {
+	polygons := [][]*geometry.Vector2{
+		createDecomposerTestPolygon(0.0, 0.0, 2.0, 0.0, 2.0, 1.0, 1.0, 1.0, 1.0, 2.0, 0.0, 2.0),
+		createDecomposerTestPolygon(0.0, 0.0, 5.0, 0.0, 5.0, 3.0, 4.0, 3.0, 4.0, 1.0, 3.0, 1.0, 3.0, 3.0, 2.0, 3.0, 2.0, 1.0, 1.0, 1.0, 1.0, 3.0, 0.0, 3.0),
+		createDecomposerTestPolygon(0.0, 3.0, -1.0, 1.0, -3.0, 1.0, -1.5, -0.5, -2.0, -3.0, 0.0, -1.5, 2.0, -3.0, 1.5, -0.5, 3.0, 1.0, 1.0, 1.0),
+		createDecomposerTestPolygon(0.0, 0.0, 4.0, 0.0, 4.0, 4.0, 3.0, 4.0, 3.0, 1.0, 1.0, 1.0, 1.0, 3.0, 2.0, 3.0, 2.0, 4.0, 0.0, 4.0),
+	}
+	for _, points := range polygons {
+		for _, decomposer := range getDecomposerTestDecomposers() {
+			convexes, err := decomposer.Decompose(points)
+			dyn4go.AssertTrue(t, err == nil)
+			dyn4go.AssertTrue(t, len(convexes) > 1)
+			assertDecomposition(t, points, convexes)
+		}
+		reversed := make([]*geometry.Vector2, len(points))
+		for i, p := range points {
+			reversed[len(points)-1-i] = p
+		}
+		for _, decomposer := range getDecomposerTestDecomposers() {
+			convexes, err := decomposer.Decompose(reversed)
+			dyn4go.AssertTrue(t, err == nil)
+			assertDecomposition(t, points, convexes)
+		}
+	}
+
+	lShape := polygons[0]
+	for _, decomposer := range getDecomposerTestDecomposers() {
+		convexes, _ := decomposer.Decompose(lShape)
+		dyn4go.AssertEqual(t, 2, len(convexes))
+	}
+}
+
+/**
+ * Tests decomposing random star shaped polygons.
+ */
+func TestDecomposeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 200; i++ {
+		points := createDecomposerTestStar(r, 5+r.Intn(60))
+		for _, decomposer := range getDecomposerTestDecomposers() {
+			convexes, err := decomposer.Decompose(points)
+			dyn4go.AssertTrue(t, err == nil)
+			assertDecomposition(t, points, convexes)
+			dyn4go.AssertTrue(t, len(convexes) <= len(points)-2)
+		}
+	}
+}
+
+/**
+ * Tests decomposing random simple polygons that are not star shaped.
+ */
+func TestDecomposeRandomSimple(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < 200; i++ {
+		points := createDecomposerTestSimple(r, 5+r.Intn(25))
+		for _, decomposer := range getDecomposerTestDecomposers() {
+			convexes, err := decomposer.Decompose(points)
+			dyn4go.AssertTrue(t, err == nil)
+			assertDecomposition(t, points, convexes)
+		}
+	}
+}
+
+/**
+ * Tests decomposing rectilinear polygons at every whole degree of rotation.
+ */
+func TestDecomposeRotatedRectilinear(t *testing.T) {
+	combs := [][]*geometry.Vector2{
+		createDecomposerTestComb(1.0, 1.0, 1.0),
+		createDecomposerTestComb(1.3, 1.6, 0.8),
+	}
+	for _, comb := range combs {
+		for degrees := 0; degrees < 360; degrees++ {
+			points := createDecomposerTestRotated(comb, float64(degrees))
+			for _, decomposer := range getDecomposerTestDecomposers() {
+				convexes, err := decomposer.Decompose(points)
+				dyn4go.AssertTrue(t, err == nil)
+				assertDecomposition(t, points, convexes)
+			}
+		}
+	}
+}
+
+/**
+ * Tests decomposing polygons with vertices within rounding error of collinear.
+ */
+func TestDecomposeNearCollinear(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	comb := createDecomposerTestComb(1.3, 1.6, 0.8)
+	for i := 0; i < 100; i++ {
+		points := make([]*geometry.Vector2, 0, 2*len(comb))
+		for j, p := range comb {
+			m := p.SumVector2(comb[(j+1)%len(comb)]).Multiply(0.5)
+			m.AddXY(1.0e-12*(r.Float64()-0.5), 1.0e-12*(r.Float64()-0.5))
+			points = append(points, geometry.NewVector2FromVector2(p), m)
+		}
+		points = createDecomposerTestRotated(points, 360.0*r.Float64())
+		for _, decomposer := range getDecomposerTestDecomposers() {
+			convexes, err := decomposer.Decompose(points)
+			dyn4go.AssertTrue(t, err == nil)
+			assertDecomposition(t, points, convexes)
+		}
+	}
+}
+
+/**
+ * Tests that pieces which are not convex are reported as numerical errors.
+ */
+func TestDecomposeCreateConvexInvalid(t *testing.T) {
+	invalid := [][]*geometry.Vector2{
+		createDecomposerTestPolygon(0.0, 0.0, 0.0, 1.0, 1.0, 0.0),
+		createDecomposerTestPolygon(0.0, 0.0, 2.0, 0.0, 1.0, 0.5, 1.0, 2.0),
+		createDecomposerTestPolygon(0.0, 0.0, 2.0, 0.0, 0.0, 1.0, 2.0, 1.0),
+	}
+	for _, vertices := range invalid {
+		convex, err := createConvex(vertices)
+		dyn4go.AssertTrue(t, convex == nil)
+		dyn4go.AssertTrue(t, errors.Is(err, ErrNumerical))
+	}
+	convex, err := createConvex(createDecomposerTestPolygon(0.0, 0.0, 1.0, 0.0, 1.0, 0.0, 2.0, 1.0e-17, 2.0, 2.0))
+	dyn4go.AssertTrue(t, err == nil)
+	dyn4go.AssertEqual(t, 3, len(convex.(geometry.Wounder).GetVertices()))
+	convex, err = createConvex(createDecomposerTestPolygon(0.0, 0.0, 1.0, 0.0, 2.0, 0.0))
+	dyn4go.AssertTrue(t, convex == nil && err == nil)
+}
+
+/**
+ * Tests that duplicate and collinear vertices are ignored.
+ */
+func TestDecomposeDuplicateCollinear(t *testing.T) {
+	points := createDecomposerTestPolygon(0.0, 0.0, 1.0, 0.0, 2.0, 0.0, 2.0, 0.0, 2.0, 1.0, 1.0, 1.0, 1.0, 2.0, 0.5, 2.0, 0.0, 2.0, 0.0, 1.0, 0.0, 0.0)
+	for _, decomposer := range getDecomposerTestDecomposers() {
+		convexes, err := decomposer.Decompose(points)
+		dyn4go.AssertTrue(t, err == nil)
+		dyn4go.AssertEqual(t, 2, len(convexes))
+		assertDecomposition(t, points, convexes)
+	}
+}
+
+/**
+ * Tests that invalid polygons are rejected with an error.
+ */
+func TestDecomposeInvalid(t *testing.T) {
+	selfIntersecting := [][]*geometry.Vector2{
+		createDecomposerTestPolygon(0.0, 0.0, 2.0, 2.0, 2.0, 0.0, 0.0, 2.0),
+		createDecomposerTestPolygon(0.0, 0.0, 4.0, 0.0, 4.0, 4.0, 2.0, 0.0, 0.0, 4.0),
+		createDecomposerTestPolygon(0.0, 0.0, 2.0, 0.0, 2.0, 2.0, 3.0, 2.0, 1.0, 2.0, 0.0, 2.0),
+		createDecomposerTestPolygon(0.0, 0.0, 2.0, 0.0, 1.0, 1.0, 2.0, 2.0, 0.0, 2.0, 1.0, 1.0),
+	}
+	tooFew := [][]*geometry.Vector2{
+		createDecomposerTestPolygon(),
+		createDecomposerTestPolygon(0.0, 0.0, 1.0, 1.0),
+		createDecomposerTestPolygon(0.0, 0.0, 1.0, 1.0, 1.0, 1.0, 0.0, 0.0),
+		createDecomposerTestPolygon(0.0, 0.0, 1.0, 1.0, 2.0, 2.0),
+	}
+	for _, decomposer := range getDecomposerTestDecomposers() {
+		for _, points := range selfIntersecting {
+			convexes, err := decomposer.Decompose(points)
+			dyn4go.AssertTrue(t, convexes == nil)
+			dyn4go.AssertTrue(t, errors.Is(err, ErrSelfIntersecting))
+		}
+		for _, points := range tooFew {
+			_, err := decomposer.Decompose(points)
+			dyn4go.AssertTrue(t, errors.Is(err, ErrTooFewVertices) || errors.Is(err, ErrSelfIntersecting))
+		}
+	}
+}
+
+/**
+ * Tests decomposing nil points.
+ */
+func TestDecomposeNil(t *testing.T) {
+	for _, decomposer := range getDecomposerTestDecomposers() {
+		func() {
+			defer dyn4go.AssertPanic(t)
+			decomposer.Decompose(nil)
+		}()
+		func() {
+			defer dyn4go.AssertPanic(t)
+			decomposer.Decompose([]*geometry.Vector2{new(geometry.Vector2), nil, geometry.NewVector2FromXY(1.0, 0.0)})
+		}()
+	}
+}
+
+/**
+ * Tests decomposing polygons with holes.
+ */
+func TestDecomposeWithHoles(t *testing.T) {
+	square := createDecomposerTestPolygon(0.0, 0.0, 10.0, 0.0, 10.0, 10.0, 0.0, 10.0)
+	holes := [][]*geometry.Vector2{
+		createDecomposerTestPolygon(2.0, 4.0, 3.0, 4.0, 3.0, 5.0, 2.0, 5.0),
+		createDecomposerTestPolygon(6.0, 4.0, 6.0, 5.0, 7.0, 5.0, 7.0, 4.0),
+		createDecomposerTestPolygon(4.0, 7.0, 5.0, 7.0, 4.5, 8.0),
+	}
+	convexes, err := new(EarClipping).DecomposeWithHoles(square, holes...)
+	dyn4go.AssertTrue(t, err == nil)
+	assertDecompositionWithHoles(t, square, holes, convexes)
+
+	r := rand.New(rand.NewSource(11))
+	for k := 0; k < 100; k++ {
+		points := createDecomposerTestStar(r, 8+r.Intn(16))
+		count := 1 + r.Intn(3)
+		holes := make([][]*geometry.Vector2, count)
+		for h := range holes {
+			angle := 2.0 * math.Pi * float64(h) / float64(count)
+			hole := createDecomposerTestStar(r, 3+r.Intn(5))
+			for _, p := range hole {
+				p.Multiply(0.05).AddXY(0.55*math.Cos(angle), 0.55*math.Sin(angle))
+			}
+			holes[h] = hole
+		}
+		convexes, err := new(EarClipping).DecomposeWithHoles(points, holes...)
+		dyn4go.AssertTrue(t, err == nil)
+		assertDecompositionWithHoles(t, points, holes, convexes)
+	}
+}
+
+func assertDecompositionWithHoles(t *testing.T, points []*geometry.Vector2, holes [][]*geometry.Vector2, convexes []geometry.Convexer) {
+	expected := getDecomposerTestArea(points)
+	for _, hole := range holes {
+		expected -= getDecomposerTestArea(hole)
+	}
+	area := 0.0
+	for _, convex := range convexes {
+		area += getDecomposerTestArea(convex.(geometry.Wounder).GetVertices())
+		dyn4go.AssertTrue(t, isDecomposerTestInside(points, convex.GetCenter()))
+		for _, hole := range holes {
+			dyn4go.AssertFalse(t, isDecomposerTestInside(hole, convex.GetCenter()))
+		}
+	}
+	dyn4go.AssertTrue(t, math.Abs(expected-area) < 1.0e-9*math.Max(1.0, area))
+}
+
+/**
+ * Tests decomposing polygons with invalid holes.
+ */
+func TestDecomposeWithHolesInvalid(t *testing.T) {
+	square := createDecomposerTestPolygon(0.0, 0.0, 4.0, 0.0, 4.0, 4.0, 0.0, 4.0)
+	hole := createDecomposerTestPolygon(1.0, 1.0, 2.0, 1.0, 2.0, 2.0, 1.0, 2.0)
+	invalid := [][][]*geometry.Vector2{
+		{createDecomposerTestPolygon(3.0, 1.0, 5.0, 1.0, 5.0, 2.0)},
+		{createDecomposerTestPolygon(5.0, 5.0, 6.0, 5.0, 6.0, 6.0)},
+		{hole, createDecomposerTestPolygon(1.5, 1.5, 3.0, 1.5, 3.0, 3.0)},
+		{hole, createDecomposerTestPolygon(1.2, 1.2, 1.8, 1.2, 1.8, 1.8)},
+		{createDecomposerTestPolygon(1.0, 1.0, 2.0, 2.0, 1.0, 2.0, 2.0, 1.0)},
+	}
+	for _, holes := range invalid {
+		convexes, err := new(EarClipping).DecomposeWithHoles(square, holes...)
+		dyn4go.AssertTrue(t, convexes == nil)
+		dyn4go.AssertTrue(t, errors.Is(err, ErrSelfIntersecting))
+	}
+	_, err := new(EarClipping).DecomposeWithHoles(square, createDecomposerTestPolygon(1.0, 1.0, 2.0, 2.0))
+	dyn4go.AssertTrue(t, errors.Is(err, ErrTooFewVertices))
+}
@@ -0,0 +1,90 @@
+package decompose
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type EarClipping struct{}
+
+var _ Decomposer = new(EarClipping)
+
+func (e *EarClipping) Decompose(points []*geometry.Vector2) ([]geometry.Convexer, error) {
+	polygon, err := getSimplePolygon(points)
+	if err != nil {
+		return nil, err
+	}
+	triangles, err := e.triangulate(polygon)
+	if err != nil {
+		return nil, err
+	}
+	return createConvexesFromIndices(polygon, mergeConvex(polygon, triangles))
+}
+
+func (e *EarClipping) DecomposeWithHoles(points []*geometry.Vector2, holes ...[]*geometry.Vector2) ([]geometry.Convexer, error) {
+	polygon, err := getBridgedPolygon(points, holes)
+	if err != nil {
+		return nil, err
+	}
+	triangles, err := e.triangulate(polygon)
+	if err != nil {
+		return nil, err
+	}
+	return createConvexesFromIndices(polygon, mergeConvex(polygon, triangles))
+}
+
+func (e *EarClipping) triangulate(polygon []*geometry.Vector2) ([][]int, error) {
+	remaining := make([]int, len(polygon))
+	for i := range remaining {
+		remaining[i] = i
+	}
+	triangles := make([][]int, 0, len(polygon)-2)
+	for len(remaining) > 3 {
+		m := len(remaining)
+		clipped := false
+		for k := 0; k < m && !clipped; k++ {
+			a := remaining[(k+m-1)%m]
+			b := remaining[k]
+			c := remaining[(k+1)%m]
+			if e.isEar(polygon, remaining, a, b, c) {
+				triangles = append(triangles, []int{a, b, c})
+				remaining = append(remaining[:k], remaining[k+1:]...)
+				clipped = true
+			}
+		}
+		for k := 0; k < m && !clipped; k++ {
+			if geometry.GetOrientation(polygon[remaining[(k+m-1)%m]], polygon[remaining[k]], polygon[remaining[(k+1)%m]]) == 0 {
+				remaining = append(remaining[:k], remaining[k+1:]...)
+				clipped = true
+			}
+		}
+		if !clipped {
+			return nil, ErrNumerical
+		}
+	}
+	if geometry.GetOrientation(polygon[remaining[0]], polygon[remaining[1]], polygon[remaining[2]]) > 0 {
+		triangles = append(triangles, remaining)
+	}
+	return triangles, nil
+}
+
+func (e *EarClipping) isEar(polygon []*geometry.Vector2, remaining []int, a, b, c int) bool {
+	pa := polygon[a]
+	pb := polygon[b]
+	pc := polygon[c]
+	if geometry.GetOrientation(pa, pb, pc) <= 0 {
+		return false
+	}
+	for _, r := range remaining {
+		if r == a || r == b || r == c {
+			continue
+		}
+		p := polygon[r]
+		if *p == *pa || *p == *pb || *p == *pc {
+			continue
+		}
+		if geometry.GetOrientation(pa, pb, p) >= 0 && geometry.GetOrientation(pb, pc, p) >= 0 && geometry.GetOrientation(pc, pa, p) >= 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,154 @@
+package decompose
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type holeList struct {
+	holes  [][]*geometry.Vector2
+	maxima []int
+}
+
+func (h *holeList) Len() int {
+	return len(h.holes)
+}
+
+func (h *holeList) Less(i, j int) bool {
+	return h.holes[i][h.maxima[i]].X > h.holes[j][h.maxima[j]].X
+}
+
+func (h *holeList) Swap(i, j int) {
+	h.holes[i], h.holes[j] = h.holes[j], h.holes[i]
+	h.maxima[i], h.maxima[j] = h.maxima[j], h.maxima[i]
+}
+
+func getBridgedPolygon(points []*geometry.Vector2, holes [][]*geometry.Vector2) ([]*geometry.Vector2, error) {
+	polygon, err := getSimplePolygon(points)
+	if err != nil {
+		return nil, err
+	}
+	list := &holeList{
+		holes:  make([][]*geometry.Vector2, len(holes)),
+		maxima: make([]int, len(holes)),
+	}
+	for i, points := range holes {
+		hole, err := getSimplePolygon(points)
+		if err != nil {
+			return nil, fmt.Errorf("hole %d: %w", i, err)
+		}
+		geometry.ReverseWindingFromList(hole)
+		if err := validateHole(polygon, list.holes[:i], hole, i); err != nil {
+			return nil, err
+		}
+		list.holes[i] = hole
+		for j, p := range hole {
+			if p.X > hole[list.maxima[i]].X {
+				list.maxima[i] = j
+			}
+		}
+	}
+	sort.Sort(list)
+	for i, hole := range list.holes {
+		m := list.maxima[i]
+		bridge := getBridgeVertex(polygon, list.holes[i:], hole[m])
+		if bridge < 0 {
+			return nil, ErrNumerical
+		}
+		bridged := make([]*geometry.Vector2, 0, len(polygon)+len(hole)+2)
+		bridged = append(bridged, polygon[:bridge+1]...)
+		for k := 0; k <= len(hole); k++ {
+			bridged = append(bridged, geometry.NewVector2FromVector2(hole[(m+k)%len(hole)]))
+		}
+		bridged = append(bridged, geometry.NewVector2FromVector2(polygon[bridge]))
+		polygon = append(bridged, polygon[bridge+1:]...)
+	}
+	return polygon, nil
+}
+
+func validateHole(polygon []*geometry.Vector2, holes [][]*geometry.Vector2, hole []*geometry.Vector2, index int) error {
+	if isPolygonIntersecting(polygon, hole) || !isInsidePolygon(polygon, hole[0]) {
+		return fmt.Errorf("%w: hole %d is not strictly inside the polygon", ErrSelfIntersecting, index)
+	}
+	for i, other := range holes {
+		if isPolygonIntersecting(other, hole) || isInsidePolygon(other, hole[0]) || isInsidePolygon(hole, other[0]) {
+			return fmt.Errorf("%w: holes %d and %d overlap", ErrSelfIntersecting, i, index)
+		}
+	}
+	return nil
+}
+
+func isPolygonIntersecting(a, b []*geometry.Vector2) bool {
+	for i := range a {
+		for j := range b {
+			if geometry.IsSegmentIntersecting(a[i], a[(i+1)%len(a)], b[j], b[(j+1)%len(b)]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isInsidePolygon(polygon []*geometry.Vector2, p *geometry.Vector2) bool {
+	inside := false
+	for i := range polygon {
+		a := polygon[i]
+		b := polygon[(i+1)%len(polygon)]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < a.X+(p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func getBridgeVertex(polygon []*geometry.Vector2, holes [][]*geometry.Vector2, m *geometry.Vector2) int {
+	n := len(polygon)
+	best := -1
+	bestDistance := 0.0
+	for i, v := range polygon {
+		prev := polygon[(i+n-1)%n]
+		next := polygon[(i+1)%n]
+		if geometry.GetOrientation(prev, v, next) > 0 {
+			if geometry.GetOrientation(prev, v, m) <= 0 || geometry.GetOrientation(v, next, m) <= 0 {
+				continue
+			}
+		} else if geometry.GetOrientation(prev, v, m) <= 0 && geometry.GetOrientation(v, next, m) <= 0 {
+			continue
+		}
+		distance := v.DistanceSquaredFromVector2(m)
+		if best >= 0 && distance >= bestDistance {
+			continue
+		}
+		if isBridgeBlocked(polygon, v, m) {
+			continue
+		}
+		blocked := false
+		for _, hole := range holes {
+			if isBridgeBlocked(hole, v, m) {
+				blocked = true
+				break
+			}
+		}
+		if !blocked {
+			best = i
+			bestDistance = distance
+		}
+	}
+	return best
+}
+
+func isBridgeBlocked(polygon []*geometry.Vector2, v, m *geometry.Vector2) bool {
+	for i := range polygon {
+		a := polygon[i]
+		b := polygon[(i+1)%len(polygon)]
+		if *a == *v || *b == *v || *a == *m || *b == *m {
+			continue
+		}
+		if geometry.IsSegmentIntersecting(v, m, a, b) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package decompose
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func getFaces(polygon []*geometry.Vector2, diagonals [][2]int) [][]int {
+	n := len(polygon)
+	neighbours := make([][]int, n)
+	starts := make([][2]int, 0, n+2*len(diagonals))
+	for i := range polygon {
+		neighbours[i] = append(neighbours[i], (i+1)%n, (i+n-1)%n)
+		starts = append(starts, [2]int{i, (i + 1) % n})
+	}
+	for _, d := range diagonals {
+		neighbours[d[0]] = append(neighbours[d[0]], d[1])
+		neighbours[d[1]] = append(neighbours[d[1]], d[0])
+		starts = append(starts, d, [2]int{d[1], d[0]})
+	}
+	visited := make(map[[2]int]bool)
+	faces := make([][]int, 0, len(diagonals)+1)
+	for _, start := range starts {
+		if visited[start] {
+			continue
+		}
+		face := make([]int, 0)
+		for e := start; !visited[e]; {
+			visited[e] = true
+			face = append(face, e[0])
+			e = [2]int{e[1], getNextNeighbour(polygon, neighbours[e[1]], e[0], e[1])}
+		}
+		faces = append(faces, face)
+	}
+	return faces
+}
+
+func getNextNeighbour(polygon []*geometry.Vector2, neighbours []int, from, at int) int {
+	p := polygon[at]
+	back := math.Atan2(polygon[from].Y-p.Y, polygon[from].X-p.X)
+	best := -1
+	bestAngle := math.Inf(1)
+	for _, c := range neighbours {
+		if c == from {
+			continue
+		}
+		angle := back - math.Atan2(polygon[c].Y-p.Y, polygon[c].X-p.X)
+		for angle <= 0 {
+			angle += 2 * math.Pi
+		}
+		if angle < bestAngle {
+			bestAngle = angle
+			best = c
+		}
+	}
+	return best
+}
+
+func mergeConvex(polygon []*geometry.Vector2, pieces [][]int) [][]int {
+	for merged := true; merged; {
+		merged = false
+		for i := 0; i < len(pieces) && !merged; i++ {
+			for j := i + 1; j < len(pieces); j++ {
+				if m := mergePieces(polygon, pieces[i], pieces[j]); m != nil {
+					pieces[i] = m
+					pieces = append(pieces[:j], pieces[j+1:]...)
+					merged = true
+					break
+				}
+			}
+		}
+	}
+	return pieces
+}
+
+func mergePieces(polygon []*geometry.Vector2, p, q []int) []int {
+	for k := range p {
+		a := p[k]
+		b := p[(k+1)%len(p)]
+		for l := range q {
+			if q[l] != b || q[(l+1)%len(q)] != a {
+				continue
+			}
+			merged := make([]int, 0, len(p)+len(q)-2)
+			for m := 1; m <= len(p); m++ {
+				merged = append(merged, p[(k+m)%len(p)])
+			}
+			for m := 2; m < len(q); m++ {
+				merged = append(merged, q[(l+m)%len(q)])
+			}
+			n := len(merged)
+			for m := range merged {
+				if geometry.GetOrientation(polygon[merged[(m+n-1)%n]], polygon[merged[m]], polygon[merged[(m+1)%n]]) <= 0 {
+					return nil
+				}
+			}
+			return merged
+		}
+	}
+	return nil
+}
+
+func createConvexesFromIndices(polygon []*geometry.Vector2, pieces [][]int) ([]geometry.Convexer, error) {
+	convexes := make([]geometry.Convexer, 0, len(pieces))
+	for _, piece := range pieces {
+		vertices := make([]*geometry.Vector2, len(piece))
+		for i, index := range piece {
+			vertices[i] = polygon[index]
+		}
+		convex, err := createConvex(vertices)
+		if err != nil {
+			return nil, err
+		}
+		if convex != nil {
+			convexes = append(convexes, convex)
+		}
+	}
+	return convexes, nil
+}
+
+func createConvex(vertices []*geometry.Vector2) (geometry.Convexer, error) {
+	vertices = append([]*geometry.Vector2(nil), vertices...)
+	for removed := true; removed && len(vertices) >= 3; {
+		removed = false
+		n := len(vertices)
+		for i := range vertices {
+			if *vertices[i] == *vertices[(i+1)%n] || geometry.GetOrientation(vertices[(i+n-1)%n], vertices[i], vertices[(i+1)%n]) == 0 {
+				vertices = append(vertices[:i], vertices[i+1:]...)
+				removed = true
+				break
+			}
+		}
+	}
+	if len(vertices) < 3 {
+		return nil, nil
+	}
+	n := len(vertices)
+	for i := range vertices {
+		a := vertices[i]
+		b := vertices[(i+1)%n]
+		for j := 2; j < n; j++ {
+			if geometry.GetOrientation(a, b, vertices[(i+j)%n]) <= 0 {
+				return nil, fmt.Errorf("%w: piece is not convex", ErrNumerical)
+			}
+		}
+	}
+	copies := make([]*geometry.Vector2, len(vertices))
+	for i, v := range vertices {
+		copies[i] = geometry.NewVector2FromVector2(v)
+	}
+	if len(copies) == 3 {
+		return geometry.CreateTriangle(copies[0], copies[1], copies[2]), nil
+	}
+	return geometry.NewPolygon(copies...), nil
+}
@@ -0,0 +1,233 @@
+package decompose
+
+import (
+	"math"
+	"sort"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+const (
+	SWEEP_VERTEX_START = iota
+	SWEEP_VERTEX_END
+	SWEEP_VERTEX_SPLIT
+	SWEEP_VERTEX_MERGE
+	SWEEP_VERTEX_REGULAR
+)
+
+type SweepLine struct{}
+
+var _ Decomposer = new(SweepLine)
+
+func (s *SweepLine) Decompose(points []*geometry.Vector2) ([]geometry.Convexer, error) {
+	polygon, err := getSimplePolygon(points)
+	if err != nil {
+		return nil, err
+	}
+	diagonals, err := s.getMonotoneDiagonals(polygon)
+	if err != nil {
+		return nil, err
+	}
+	for _, face := range getFaces(polygon, diagonals) {
+		diagonals = append(diagonals, s.getTriangulationDiagonals(polygon, face)...)
+	}
+	triangles := getFaces(polygon, diagonals)
+	return createConvexesFromIndices(polygon, mergeConvex(polygon, triangles))
+}
+
+func isAbove(p, q *geometry.Vector2) bool {
+	return p.Y > q.Y || (p.Y == q.Y && p.X < q.X)
+}
+
+type sweepEventList struct {
+	polygon []*geometry.Vector2
+	indices []int
+}
+
+func (s *sweepEventList) Len() int {
+	return len(s.indices)
+}
+
+func (s *sweepEventList) Less(i, j int) bool {
+	return isAbove(s.polygon[s.indices[i]], s.polygon[s.indices[j]])
+}
+
+func (s *sweepEventList) Swap(i, j int) {
+	s.indices[i], s.indices[j] = s.indices[j], s.indices[i]
+}
+
+func getSortedEvents(polygon []*geometry.Vector2, indices []int) []int {
+	events := &sweepEventList{polygon, append([]int(nil), indices...)}
+	sort.Sort(events)
+	return events.indices
+}
+
+func (s *SweepLine) getVertexType(polygon []*geometry.Vector2, i int) int {
+	n := len(polygon)
+	prev := polygon[(i+n-1)%n]
+	next := polygon[(i+1)%n]
+	v := polygon[i]
+	convex := geometry.GetOrientation(prev, v, next) > 0
+	if isAbove(v, prev) && isAbove(v, next) {
+		if convex {
+			return SWEEP_VERTEX_START
+		}
+		return SWEEP_VERTEX_SPLIT
+	}
+	if isAbove(prev, v) && isAbove(next, v) {
+		if convex {
+			return SWEEP_VERTEX_END
+		}
+		return SWEEP_VERTEX_MERGE
+	}
+	return SWEEP_VERTEX_REGULAR
+}
+
+func (s *SweepLine) getMonotoneDiagonals(polygon []*geometry.Vector2) ([][2]int, error) {
+	n := len(polygon)
+	indices := make([]int, n)
+	types := make([]int, n)
+	for i := range polygon {
+		indices[i] = i
+		types[i] = s.getVertexType(polygon, i)
+	}
+	helper := make([]int, n)
+	status := make([]int, 0)
+	diagonals := make([][2]int, 0)
+	connectMerge := func(i, e int) {
+		if types[helper[e]] == SWEEP_VERTEX_MERGE {
+			diagonals = append(diagonals, [2]int{i, helper[e]})
+		}
+	}
+	remove := func(e int) {
+		for k, edge := range status {
+			if edge == e {
+				status = append(status[:k], status[k+1:]...)
+				return
+			}
+		}
+	}
+	for _, i := range getSortedEvents(polygon, indices) {
+		prevEdge := (i + n - 1) % n
+		switch types[i] {
+		case SWEEP_VERTEX_START:
+			status = append(status, i)
+			helper[i] = i
+		case SWEEP_VERTEX_END:
+			connectMerge(i, prevEdge)
+			remove(prevEdge)
+		case SWEEP_VERTEX_SPLIT:
+			left := s.getLeftEdge(polygon, status, i)
+			if left < 0 {
+				return nil, ErrNumerical
+			}
+			diagonals = append(diagonals, [2]int{i, helper[left]})
+			helper[left] = i
+			status = append(status, i)
+			helper[i] = i
+		case SWEEP_VERTEX_MERGE:
+			connectMerge(i, prevEdge)
+			remove(prevEdge)
+			left := s.getLeftEdge(polygon, status, i)
+			if left < 0 {
+				return nil, ErrNumerical
+			}
+			connectMerge(i, left)
+			helper[left] = i
+		default:
+			if isAbove(polygon[(i+n-1)%n], polygon[i]) {
+				connectMerge(i, prevEdge)
+				remove(prevEdge)
+				status = append(status, i)
+				helper[i] = i
+			} else {
+				left := s.getLeftEdge(polygon, status, i)
+				if left < 0 {
+					return nil, ErrNumerical
+				}
+				connectMerge(i, left)
+				helper[left] = i
+			}
+		}
+	}
+	return diagonals, nil
+}
+
+func (s *SweepLine) getLeftEdge(polygon []*geometry.Vector2, status []int, i int) int {
+	n := len(polygon)
+	v := polygon[i]
+	best := -1
+	bestX := math.Inf(-1)
+	for _, e := range status {
+		x := getSweepX(polygon[e], polygon[(e+1)%n], v.Y)
+		if x <= v.X && x > bestX {
+			best = e
+			bestX = x
+		}
+	}
+	return best
+}
+
+func getSweepX(a, b *geometry.Vector2, y float64) float64 {
+	if a.Y == b.Y {
+		return math.Max(a.X, b.X)
+	}
+	t := math.Max(0, math.Min(1, (y-a.Y)/(b.Y-a.Y)))
+	return a.X + t*(b.X-a.X)
+}
+
+func (s *SweepLine) getTriangulationDiagonals(polygon []*geometry.Vector2, face []int) [][2]int {
+	m := len(face)
+	diagonals := make([][2]int, 0)
+	if m <= 3 {
+		return diagonals
+	}
+	position := make(map[int]int, m)
+	for k, index := range face {
+		position[index] = k
+	}
+	sorted := getSortedEvents(polygon, face)
+	top := position[sorted[0]]
+	bottom := position[sorted[m-1]]
+	left := make([]bool, m)
+	for k := (top + 1) % m; k != bottom; k = (k + 1) % m {
+		left[k] = true
+	}
+
+	stack := []int{sorted[0], sorted[1]}
+	for j := 2; j < m-1; j++ {
+		u := sorted[j]
+		onLeft := left[position[u]]
+		if onLeft != left[position[stack[len(stack)-1]]] {
+			for len(stack) > 1 {
+				diagonals = append(diagonals, [2]int{u, stack[len(stack)-1]})
+				stack = stack[:len(stack)-1]
+			}
+			stack = []int{sorted[j-1], u}
+		} else {
+			last := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			for len(stack) > 0 {
+				candidate := stack[len(stack)-1]
+				var o float64
+				if onLeft {
+					o = geometry.GetOrientation(polygon[candidate], polygon[last], polygon[u])
+				} else {
+					o = geometry.GetOrientation(polygon[u], polygon[last], polygon[candidate])
+				}
+				if o <= 0 {
+					break
+				}
+				diagonals = append(diagonals, [2]int{u, candidate})
+				last = candidate
+				stack = stack[:len(stack)-1]
+			}
+			stack = append(stack, last, u)
+		}
+	}
+	u := sorted[m-1]
+	for k := len(stack) - 2; k > 0; k-- {
+		diagonals = append(diagonals, [2]int{u, stack[k]})
+	}
+	return diagonals
+}
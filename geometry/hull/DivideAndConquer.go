@@ -0,0 +1,119 @@
+package hull
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type DivideAndConquer struct{}
+
+var _ HullGenerator = new(DivideAndConquer)
+
+func (d *DivideAndConquer) Generate(points []*geometry.Vector2) []*geometry.Vector2 {
+	sorted := getUniquePoints(points)
+	if len(sorted) < 3 {
+		return sorted
+	}
+	return d.divide(sorted)
+}
+
+func (d *DivideAndConquer) divide(sorted []*geometry.Vector2) []*geometry.Vector2 {
+	n := len(sorted)
+	if n < 3 {
+		return append([]*geometry.Vector2(nil), sorted...)
+	}
+	if n == 3 {
+		o := getOrientation(sorted[0], sorted[1], sorted[2])
+		if o > 0 {
+			return []*geometry.Vector2{sorted[0], sorted[1], sorted[2]}
+		} else if o < 0 {
+			return []*geometry.Vector2{sorted[0], sorted[2], sorted[1]}
+		}
+		return []*geometry.Vector2{sorted[0], sorted[2]}
+	}
+	return d.merge(d.divide(sorted[:n/2]), d.divide(sorted[n/2:]))
+}
+
+func (d *DivideAndConquer) merge(left, right []*geometry.Vector2) []*geometry.Vector2 {
+	nl := len(left)
+	nr := len(right)
+	rightmost := 0
+	for i := range left {
+		if pointList(left).Less(rightmost, i) {
+			rightmost = i
+		}
+	}
+	leftmost := 0
+	for j := range right {
+		if pointList(right).Less(j, leftmost) {
+			leftmost = j
+		}
+	}
+
+	upperL, upperR := rightmost, leftmost
+	for moved := true; moved; {
+		moved = false
+		for {
+			next := (upperL + 1) % nl
+			if !isTangentAdvance(right[upperR], left[upperL], left[next], 1) {
+				break
+			}
+			upperL = next
+			moved = true
+		}
+		for {
+			prev := (upperR + nr - 1) % nr
+			if !isTangentAdvance(left[upperL], right[upperR], right[prev], -1) {
+				break
+			}
+			upperR = prev
+			moved = true
+		}
+	}
+
+	lowerL, lowerR := rightmost, leftmost
+	for moved := true; moved; {
+		moved = false
+		for {
+			prev := (lowerL + nl - 1) % nl
+			if !isTangentAdvance(right[lowerR], left[lowerL], left[prev], -1) {
+				break
+			}
+			lowerL = prev
+			moved = true
+		}
+		for {
+			next := (lowerR + 1) % nr
+			if !isTangentAdvance(left[lowerL], right[lowerR], right[next], 1) {
+				break
+			}
+			lowerR = next
+			moved = true
+		}
+	}
+
+	hull := make([]*geometry.Vector2, 0, nl+nr)
+	for i := upperL; ; i = (i + 1) % nl {
+		hull = append(hull, left[i])
+		if i == lowerL {
+			break
+		}
+	}
+	for j := lowerR; ; j = (j + 1) % nr {
+		hull = append(hull, right[j])
+		if j == upperR {
+			break
+		}
+	}
+	return hull
+}
+
+func isTangentAdvance(anchor, current, candidate *geometry.Vector2, side float64) bool {
+	if candidate == current {
+		return false
+	}
+	o := getOrientation(anchor, current, candidate) * side
+	if o < 0 {
+		return true
+	}
+	return o == 0 && anchor.DistanceSquaredFromVector2(candidate) > anchor.DistanceSquaredFromVector2(current)
+}
@@ -0,0 +1,40 @@
+package hull
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type GiftWrap struct{}
+
+var _ HullGenerator = new(GiftWrap)
+
+func (g *GiftWrap) Generate(points []*geometry.Vector2) []*geometry.Vector2 {
+	unique := getUniquePoints(points)
+	if len(unique) < 3 {
+		return unique
+	}
+	start := unique[0]
+	hull := make([]*geometry.Vector2, 0)
+	p := start
+	for len(hull) < len(unique) {
+		hull = append(hull, p)
+		q := unique[0]
+		if q == p {
+			q = unique[1]
+		}
+		for _, r := range unique {
+			if r == p {
+				continue
+			}
+			o := getOrientation(p, q, r)
+			if o < 0 || (o == 0 && p.DistanceSquaredFromVector2(r) > p.DistanceSquaredFromVector2(q)) {
+				q = r
+			}
+		}
+		p = q
+		if p == start {
+			break
+		}
+	}
+	return hull
+}
@@ -0,0 +1,58 @@
+package hull
+
+import (
+	"sort"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type GrahamScan struct{}
+
+var _ HullGenerator = new(GrahamScan)
+
+func (g *GrahamScan) Generate(points []*geometry.Vector2) []*geometry.Vector2 {
+	unique := getUniquePoints(points)
+	if len(unique) < 3 {
+		return unique
+	}
+	pivot := 0
+	for i, p := range unique {
+		if p.Y < unique[pivot].Y || (p.Y == unique[pivot].Y && p.X < unique[pivot].X) {
+			pivot = i
+		}
+	}
+	unique[0], unique[pivot] = unique[pivot], unique[0]
+	others := &angleList{unique[0], unique[1:]}
+	sort.Sort(others)
+
+	hull := make([]*geometry.Vector2, 0, len(unique))
+	hull = append(hull, unique[0])
+	for _, p := range others.points {
+		for len(hull) >= 2 && getOrientation(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	return hull
+}
+
+type angleList struct {
+	pivot  *geometry.Vector2
+	points []*geometry.Vector2
+}
+
+func (a *angleList) Len() int {
+	return len(a.points)
+}
+
+func (a *angleList) Less(i, j int) bool {
+	o := getOrientation(a.pivot, a.points[i], a.points[j])
+	if o == 0 {
+		return a.pivot.DistanceSquaredFromVector2(a.points[i]) < a.pivot.DistanceSquaredFromVector2(a.points[j])
+	}
+	return o > 0
+}
+
+func (a *angleList) Swap(i, j int) {
+	a.points[i], a.points[j] = a.points[j], a.points[i]
+}
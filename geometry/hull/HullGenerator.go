@@ -0,0 +1,54 @@
+package hull
+
+import (
+	"sort"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type HullGenerator interface {
+	Generate(points []*geometry.Vector2) []*geometry.Vector2
+}
+
+type pointList []*geometry.Vector2
+
+func (p pointList) Len() int {
+	return len(p)
+}
+
+func (p pointList) Less(i, j int) bool {
+	if p[i].X == p[j].X {
+		return p[i].Y < p[j].Y
+	}
+	return p[i].X < p[j].X
+}
+
+func (p pointList) Swap(i, j int) {
+	p[i], p[j] = p[j], p[i]
+}
+
+func getUniquePoints(points []*geometry.Vector2) []*geometry.Vector2 {
+	if points == nil {
+		panic("Cannot generate hull from nil points")
+	}
+	sorted := make(pointList, len(points))
+	for i, p := range points {
+		if p == nil {
+			panic("Cannot generate hull from nil points")
+		}
+		sorted[i] = p
+	}
+	sort.Sort(sorted)
+	unique := make([]*geometry.Vector2, 0, len(sorted))
+	for _, p := range sorted {
+		if len(unique) > 0 && *unique[len(unique)-1] == *p {
+			continue
+		}
+		unique = append(unique, geometry.NewVector2FromVector2(p))
+	}
+	return unique
+}
+
+func getOrientation(o, a, b *geometry.Vector2) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
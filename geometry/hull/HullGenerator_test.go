@@ -0,0 +1,178 @@
+package hull
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func getHullTestGenerators() []HullGenerator {
+	return []HullGenerator{
+		new(GiftWrap),
+		new(GrahamScan),
+		new(MonotoneChain),
+		new(DivideAndConquer),
+		new(QuickHull),
+	}
+}
+
+func createHullTestRandomPoints(r *rand.Rand, count int) []*geometry.Vector2 {
+	points := make([]*geometry.Vector2, count)
+	for i := range points {
+		points[i] = geometry.NewVector2FromXY(r.Float64()*20.0-10.0, r.Float64()*20.0-10.0)
+	}
+	return points
+}
+
+func createHullTestGridPoints(r *rand.Rand, count int) []*geometry.Vector2 {
+	points := make([]*geometry.Vector2, count)
+	for i := range points {
+		points[i] = geometry.NewVector2FromXY(float64(r.Intn(7)), float64(r.Intn(5)))
+	}
+	return points
+}
+
+func assertHullValid(t *testing.T, points, hull []*geometry.Vector2) {
+	n := len(hull)
+	dyn4go.AssertTrue(t, n >= 3)
+	for i := range hull {
+		p0 := hull[(i+n-1)%n]
+		p1 := hull[i]
+		p2 := hull[(i+1)%n]
+		dyn4go.AssertTrue(t, getOrientation(p0, p1, p2) > 0)
+		for _, p := range points {
+			dyn4go.AssertTrue(t, getOrientation(p1, p2, p) >= -1.0e-9)
+		}
+	}
+	geometry.NewPolygon(hull...)
+}
+
+func assertHullEqual(t *testing.T, expected, actual []*geometry.Vector2) {
+	dyn4go.AssertEqual(t, len(expected), len(actual))
+	offset := 0
+	for i, p := range actual {
+		if *p == *expected[0] {
+			offset = i
+		}
+	}
+	for i, p := range expected {
+		dyn4go.AssertEqual(t, *p, *actual[(i+offset)%len(actual)])
+	}
+}
+
+/**
+ * Tests that every generator produces the same valid hull for random point clouds.
+ */
+func TestHullGeneratorsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	reference := new(MonotoneChain)
+	for i := 0; i < 100; i++ {
+		points := createHullTestRandomPoints(r, 3+r.Intn(200))
+		expected := reference.Generate(points)
+		assertHullValid(t, points, expected)
+		for _, generator := range getHullTestGenerators() {
+			assertHullEqual(t, expected, generator.Generate(points))
+		}
+	}
+}
+
+/**
+ * Tests that every generator drops collinear and duplicate points.
+ */
+func TestHullGeneratorsCollinearDuplicate(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	reference := new(MonotoneChain)
+	for i := 0; i < 100; i++ {
+		points := createHullTestGridPoints(r, 4+r.Intn(60))
+		expected := reference.Generate(points)
+		if len(expected) < 3 {
+			continue
+		}
+		assertHullValid(t, points, expected)
+		for _, generator := range getHullTestGenerators() {
+			assertHullEqual(t, expected, generator.Generate(points))
+		}
+	}
+
+	square := []*geometry.Vector2{
+		geometry.NewVector2FromXY(0.0, 0.0),
+		geometry.NewVector2FromXY(1.0, 0.0),
+		geometry.NewVector2FromXY(2.0, 0.0),
+		geometry.NewVector2FromXY(2.0, 1.0),
+		geometry.NewVector2FromXY(2.0, 2.0),
+		geometry.NewVector2FromXY(1.0, 2.0),
+		geometry.NewVector2FromXY(0.0, 2.0),
+		geometry.NewVector2FromXY(0.0, 1.0),
+		geometry.NewVector2FromXY(1.0, 1.0),
+		geometry.NewVector2FromXY(2.0, 2.0),
+		geometry.NewVector2FromXY(0.0, 0.0),
+	}
+	for _, generator := range getHullTestGenerators() {
+		hull := generator.Generate(square)
+		dyn4go.AssertEqual(t, 4, len(hull))
+		assertHullValid(t, square, hull)
+	}
+}
+
+/**
+ * Tests the generators with fewer than three distinct or non-collinear points.
+ */
+func TestHullGeneratorsDegenerate(t *testing.T) {
+	for _, generator := range getHullTestGenerators() {
+		dyn4go.AssertEqual(t, 0, len(generator.Generate([]*geometry.Vector2{})))
+
+		hull := generator.Generate([]*geometry.Vector2{
+			geometry.NewVector2FromXY(1.0, 1.0),
+			geometry.NewVector2FromXY(1.0, 1.0),
+			geometry.NewVector2FromXY(1.0, 1.0),
+		})
+		dyn4go.AssertEqual(t, 1, len(hull))
+
+		hull = generator.Generate([]*geometry.Vector2{
+			geometry.NewVector2FromXY(1.0, 1.0),
+			geometry.NewVector2FromXY(3.0, 3.0),
+			geometry.NewVector2FromXY(0.0, 0.0),
+			geometry.NewVector2FromXY(2.0, 2.0),
+			geometry.NewVector2FromXY(3.0, 3.0),
+		})
+		dyn4go.AssertEqual(t, 2, len(hull))
+		dyn4go.AssertTrue(t, *hull[0] == geometry.Vector2{X: 0.0, Y: 0.0} || *hull[1] == geometry.Vector2{X: 0.0, Y: 0.0})
+		dyn4go.AssertTrue(t, *hull[0] == geometry.Vector2{X: 3.0, Y: 3.0} || *hull[1] == geometry.Vector2{X: 3.0, Y: 3.0})
+	}
+}
+
+/**
+ * Tests that the generators copy the input points.
+ */
+func TestHullGeneratorsCopy(t *testing.T) {
+	points := []*geometry.Vector2{
+		geometry.NewVector2FromXY(0.0, 0.0),
+		geometry.NewVector2FromXY(1.0, 0.0),
+		geometry.NewVector2FromXY(0.0, 1.0),
+	}
+	for _, generator := range getHullTestGenerators() {
+		for _, p := range generator.Generate(points) {
+			for _, q := range points {
+				dyn4go.AssertTrue(t, p != q)
+			}
+		}
+	}
+}
+
+/**
+ * Tests generating a hull from nil points.
+ */
+func TestHullGeneratorsNil(t *testing.T) {
+	for _, generator := range getHullTestGenerators() {
+		func() {
+			defer dyn4go.AssertPanic(t)
+			generator.Generate(nil)
+		}()
+		func() {
+			defer dyn4go.AssertPanic(t)
+			generator.Generate([]*geometry.Vector2{new(geometry.Vector2), nil, geometry.NewVector2FromXY(1.0, 0.0)})
+		}()
+	}
+}
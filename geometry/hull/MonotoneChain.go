@@ -0,0 +1,32 @@
+package hull
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type MonotoneChain struct{}
+
+var _ HullGenerator = new(MonotoneChain)
+
+func (m *MonotoneChain) Generate(points []*geometry.Vector2) []*geometry.Vector2 {
+	sorted := getUniquePoints(points)
+	if len(sorted) < 3 {
+		return sorted
+	}
+	lower := make([]*geometry.Vector2, 0, len(sorted))
+	for _, p := range sorted {
+		for len(lower) >= 2 && getOrientation(lower[len(lower)-2], lower[len(lower)-1], p) <= 0 {
+			lower = lower[:len(lower)-1]
+		}
+		lower = append(lower, p)
+	}
+	upper := make([]*geometry.Vector2, 0, len(sorted))
+	for i := len(sorted) - 1; i >= 0; i-- {
+		p := sorted[i]
+		for len(upper) >= 2 && getOrientation(upper[len(upper)-2], upper[len(upper)-1], p) <= 0 {
+			upper = upper[:len(upper)-1]
+		}
+		upper = append(upper, p)
+	}
+	return append(lower[:len(lower)-1], upper[:len(upper)-1]...)
+}
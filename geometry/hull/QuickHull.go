@@ -0,0 +1,60 @@
+package hull
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type QuickHull struct{}
+
+var _ HullGenerator = new(QuickHull)
+
+func (q *QuickHull) Generate(points []*geometry.Vector2) []*geometry.Vector2 {
+	unique := getUniquePoints(points)
+	if len(unique) < 3 {
+		return unique
+	}
+	a := unique[0]
+	b := unique[len(unique)-1]
+	below := make([]*geometry.Vector2, 0)
+	above := make([]*geometry.Vector2, 0)
+	for _, p := range unique {
+		o := getOrientation(a, b, p)
+		if o < 0 {
+			below = append(below, p)
+		} else if o > 0 {
+			above = append(above, p)
+		}
+	}
+	hull := []*geometry.Vector2{a}
+	hull = q.expand(a, b, below, hull)
+	hull = append(hull, b)
+	return q.expand(b, a, above, hull)
+}
+
+func (q *QuickHull) expand(p1, p2 *geometry.Vector2, points, hull []*geometry.Vector2) []*geometry.Vector2 {
+	if len(points) == 0 {
+		return hull
+	}
+	edge := p1.HereToVector2(p2)
+	c := points[0]
+	cOrientation := getOrientation(p1, p2, c)
+	for _, p := range points[1:] {
+		o := getOrientation(p1, p2, p)
+		if o < cOrientation || (o == cOrientation && edge.DotVector2(p) > edge.DotVector2(c)) {
+			c = p
+			cOrientation = o
+		}
+	}
+	outside1 := make([]*geometry.Vector2, 0)
+	outside2 := make([]*geometry.Vector2, 0)
+	for _, p := range points {
+		if getOrientation(p1, c, p) < 0 {
+			outside1 = append(outside1, p)
+		} else if getOrientation(c, p2, p) < 0 {
+			outside2 = append(outside2, p)
+		}
+	}
+	hull = q.expand(p1, c, outside1, hull)
+	hull = append(hull, c)
+	return q.expand(c, p2, outside2, hull)
+}
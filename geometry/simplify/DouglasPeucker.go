@@ -0,0 +1,66 @@
+package simplify
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type DouglasPeucker struct {
+	epsilon float64
+}
+
+var _ Simplifier = new(DouglasPeucker)
+
+func NewDouglasPeucker(epsilon float64) *DouglasPeucker {
+	if epsilon < 0 {
+		panic("Epsilon must not be negative")
+	}
+	d := new(DouglasPeucker)
+	d.epsilon = epsilon
+	return d
+}
+
+func (d *DouglasPeucker) GetEpsilon() float64 {
+	return d.epsilon
+}
+
+func (d *DouglasPeucker) Simplify(vertices []*geometry.Vector2) []*geometry.Vector2 {
+	unique := getUniqueVertices(vertices)
+	n := len(unique)
+	if n <= 3 {
+		return copyVertices(unique)
+	}
+	keep := make([]bool, n)
+	far := 0
+	for i, v := range unique {
+		if v.DistanceSquaredFromVector2(unique[0]) > unique[far].DistanceSquaredFromVector2(unique[0]) {
+			far = i
+		}
+	}
+	keep[0] = true
+	keep[far] = true
+	d.simplify(unique, keep, 0, far)
+	d.simplify(unique, keep, far, n)
+	return getSimpleVertices(unique, keep)
+}
+
+func (d *DouglasPeucker) simplify(vertices []*geometry.Vector2, keep []bool, start, end int) {
+	if end-start < 2 {
+		return
+	}
+	n := len(vertices)
+	a := vertices[start%n]
+	b := vertices[end%n]
+	farthest := -1
+	distance := -1.0
+	for i := start + 1; i < end; i++ {
+		if dist := getSegmentDistance(vertices[i], a, b); dist > distance {
+			distance = dist
+			farthest = i
+		}
+	}
+	if distance > d.epsilon {
+		keep[farthest] = true
+		d.simplify(vertices, keep, start, farthest)
+		d.simplify(vertices, keep, farthest, end)
+	}
+}
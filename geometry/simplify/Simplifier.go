@@ -0,0 +1,167 @@
+package simplify
+
+import (
+	"sort"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type Simplifier interface {
+	Simplify(vertices []*geometry.Vector2) []*geometry.Vector2
+}
+
+func getUniqueVertices(vertices []*geometry.Vector2) []*geometry.Vector2 {
+	if vertices == nil {
+		panic("Cannot simplify nil vertices")
+	}
+	unique := make([]*geometry.Vector2, 0, len(vertices))
+	for _, v := range vertices {
+		if v == nil {
+			panic("Cannot simplify nil vertices")
+		}
+		if len(unique) > 0 && *unique[len(unique)-1] == *v {
+			continue
+		}
+		unique = append(unique, v)
+	}
+	for len(unique) > 1 && *unique[0] == *unique[len(unique)-1] {
+		unique = unique[:len(unique)-1]
+	}
+	return unique
+}
+
+func copyVertices(vertices []*geometry.Vector2) []*geometry.Vector2 {
+	copies := make([]*geometry.Vector2, len(vertices))
+	for i, v := range vertices {
+		copies[i] = geometry.NewVector2FromVector2(v)
+	}
+	return copies
+}
+
+func getSegmentDistance(p, a, b *geometry.Vector2) float64 {
+	return geometry.GetPointOnSegmentClosestToPoint(p, a, b).DistanceFromVector2(p)
+}
+
+func getSimpleVertices(vertices []*geometry.Vector2, keep []bool) []*geometry.Vector2 {
+	n := len(vertices)
+	winding := geometry.GetWindingFromList(vertices)
+	dirty := make([]bool, n)
+	copy(dirty, keep)
+	indices := make([]int, 0, n)
+	for i, k := range keep {
+		if k {
+			indices = append(indices, i)
+		}
+	}
+	for {
+		m := len(indices)
+		edgeDirty := make([]bool, m)
+		for k := range indices {
+			edgeDirty[k] = dirty[indices[k]] || dirty[indices[(k+1)%m]]
+		}
+		restored := make([]int, 0)
+		split := func(edges ...int) {
+			for _, k := range edges {
+				if i := splitEdge(vertices, indices, k); i >= 0 {
+					restored = append(restored, i)
+					return
+				}
+			}
+		}
+		if m < 3 {
+			split(getLongestEdge(vertices, indices))
+		} else {
+			for k := 0; k < m; k++ {
+				a1 := vertices[indices[k]]
+				a2 := vertices[indices[(k+1)%m]]
+				a3 := vertices[indices[(k+2)%m]]
+				if (edgeDirty[k] || edgeDirty[(k+1)%m]) && geometry.GetOrientation(a1, a2, a3) == 0 && a1.HereToVector2(a2).DotVector2(a2.HereToVector2(a3)) < 0 {
+					split(k, (k+1)%m)
+				}
+				if !edgeDirty[k] {
+					continue
+				}
+				prev, next := (k+m-1)%m, (k+1)%m
+				for l := 0; l < m; l++ {
+					if l == k || l == prev || l == next || (l < k && edgeDirty[l]) {
+						continue
+					}
+					if geometry.IsSegmentIntersecting(a1, a2, vertices[indices[l]], vertices[indices[(l+1)%m]]) {
+						split(k, l)
+					}
+				}
+			}
+			if len(restored) == 0 {
+				simplified := make([]*geometry.Vector2, m)
+				for k, i := range indices {
+					simplified[k] = vertices[i]
+				}
+				if w := geometry.GetWindingFromList(simplified); w == 0 || (w > 0) != (winding > 0) {
+					split(getLongestEdge(vertices, indices))
+				}
+			}
+		}
+		if len(restored) == 0 {
+			break
+		}
+		for _, i := range indices {
+			dirty[i] = false
+		}
+		for _, i := range restored {
+			dirty[i] = true
+		}
+		sort.Ints(restored)
+		merged := make([]int, 0, m+len(restored))
+		for len(indices) > 0 || len(restored) > 0 {
+			if len(restored) == 0 || (len(indices) > 0 && indices[0] < restored[0]) {
+				merged = append(merged, indices[0])
+				indices = indices[1:]
+			} else {
+				if len(merged) == 0 || merged[len(merged)-1] != restored[0] {
+					merged = append(merged, restored[0])
+				}
+				restored = restored[1:]
+			}
+		}
+		indices = merged
+	}
+	simplified := make([]*geometry.Vector2, len(indices))
+	for k, i := range indices {
+		simplified[k] = vertices[i]
+	}
+	return copyVertices(simplified)
+}
+
+func splitEdge(vertices []*geometry.Vector2, indices []int, k int) int {
+	n := len(vertices)
+	m := len(indices)
+	a := indices[k]
+	b := indices[(k+1)%m]
+	farthest := -1
+	distance := -1.0
+	for i := (a + 1) % n; i != b; i = (i + 1) % n {
+		if d := getSegmentDistance(vertices[i], vertices[a], vertices[b]); d > distance {
+			distance = d
+			farthest = i
+		}
+	}
+	return farthest
+}
+
+func getLongestEdge(vertices []*geometry.Vector2, indices []int) int {
+	n := len(vertices)
+	m := len(indices)
+	longest := 0
+	length := -1
+	for k := range indices {
+		l := (indices[(k+1)%m] - indices[k] + n) % n
+		if l == 0 {
+			l = n
+		}
+		if l > length {
+			length = l
+			longest = k
+		}
+	}
+	return longest
+}
@@ -0,0 +1,204 @@
+package simplify
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/LSFN/dyn4go"
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+func createSimplifierTestCircle(r *rand.Rand, count int, radius, noise float64) []*geometry.Vector2 {
+	vertices := make([]*geometry.Vector2, count)
+	for i := range vertices {
+		angle := 2.0 * math.Pi * float64(i) / float64(count)
+		d := radius + noise*(2.0*r.Float64()-1.0)
+		vertices[i] = geometry.NewVector2FromXY(d*math.Cos(angle), d*math.Sin(angle))
+	}
+	return vertices
+}
+
+func createSimplifierTestSpiral(r *rand.Rand, count int, noise float64) []*geometry.Vector2 {
+	vertices := make([]*geometry.Vector2, 0, 2*count)
+	for i := 0; i < count; i++ {
+		theta := 4.0 * math.Pi * float64(i) / float64(count-1)
+		d := 3.0 + 0.5*theta + noise*r.Float64()
+		vertices = append(vertices, geometry.NewVector2FromXY(d*math.Cos(theta), d*math.Sin(theta)))
+	}
+	for i := count - 1; i >= 0; i-- {
+		theta := 4.0 * math.Pi * float64(i) / float64(count-1)
+		d := 0.5 + 0.5*theta - noise*r.Float64()
+		vertices = append(vertices, geometry.NewVector2FromXY(d*math.Cos(theta), d*math.Sin(theta)))
+	}
+	return vertices
+}
+
+func isSimplifierTestSimple(vertices []*geometry.Vector2) bool {
+	n := len(vertices)
+	for i := 0; i < n; i++ {
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue
+			}
+			if geometry.IsSegmentIntersecting(vertices[i], vertices[(i+1)%n], vertices[j], vertices[(j+1)%n]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func getSimplifierTestArea(vertices []*geometry.Vector2) float64 {
+	return math.Abs(geometry.GetWindingFromList(vertices)) * 0.5
+}
+
+/**
+ * Tests that noisy outlines lose most of their vertices but keep their shape.
+ */
+func TestSimplifyNoisyCircle(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	vertices := createSimplifierTestCircle(r, 2000, 10.0, 0.01)
+	simplifiers := []Simplifier{
+		NewDouglasPeucker(0.1),
+		NewVisvalingamWhyatt(0.1),
+		NewVertexCluster(0.5),
+	}
+	for _, simplifier := range simplifiers {
+		simplified := simplifier.Simplify(vertices)
+		dyn4go.AssertTrue(t, len(simplified) >= 3)
+		dyn4go.AssertTrue(t, len(simplified) < 200)
+		dyn4go.AssertTrue(t, isSimplifierTestSimple(simplified))
+		dyn4go.AssertEqualWithinError(t, getSimplifierTestArea(vertices), getSimplifierTestArea(simplified), 0.02*getSimplifierTestArea(vertices))
+		dyn4go.AssertTrue(t, geometry.GetWindingFromList(simplified) > 0)
+	}
+}
+
+/**
+ * Tests that simplifying tightly wound outlines never creates self-intersections.
+ */
+func TestSimplifyStaysSimple(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	crossings := 0
+	for i := 0; i < 20; i++ {
+		vertices := createSimplifierTestSpiral(r, 100+r.Intn(200), 0.3)
+		dyn4go.AssertTrue(t, isSimplifierTestSimple(vertices))
+		tolerance := 0.5 + 2.0*r.Float64()
+		simplifiers := []Simplifier{
+			NewDouglasPeucker(tolerance),
+			NewVisvalingamWhyatt(tolerance * tolerance),
+			NewVertexCluster(tolerance),
+		}
+		for _, simplifier := range simplifiers {
+			simplified := simplifier.Simplify(vertices)
+			dyn4go.AssertTrue(t, len(simplified) >= 3)
+			dyn4go.AssertTrue(t, len(simplified) < len(vertices))
+			dyn4go.AssertTrue(t, isSimplifierTestSimple(simplified))
+			dyn4go.AssertTrue(t, geometry.GetWindingFromList(simplified) != 0)
+		}
+
+		keep := make([]bool, len(vertices))
+		keep[0] = true
+		keep[len(vertices)/2] = true
+		NewDouglasPeucker(tolerance).simplify(vertices, keep, 0, len(vertices)/2)
+		NewDouglasPeucker(tolerance).simplify(vertices, keep, len(vertices)/2, len(vertices))
+		naive := make([]*geometry.Vector2, 0)
+		for k, v := range vertices {
+			if keep[k] {
+				naive = append(naive, v)
+			}
+		}
+		if !isSimplifierTestSimple(naive) {
+			crossings++
+		}
+	}
+	dyn4go.AssertTrue(t, crossings > 0)
+}
+
+/**
+ * Tests simplifying small and duplicate inputs.
+ */
+func TestSimplifyDegenerate(t *testing.T) {
+	simplifiers := []Simplifier{
+		NewDouglasPeucker(100.0),
+		NewVisvalingamWhyatt(100.0),
+		NewVertexCluster(100.0),
+	}
+	square := []*geometry.Vector2{
+		geometry.NewVector2FromXY(0.0, 0.0),
+		geometry.NewVector2FromXY(0.0, 0.0),
+		geometry.NewVector2FromXY(1.0, 0.0),
+		geometry.NewVector2FromXY(1.0, 1.0),
+		geometry.NewVector2FromXY(0.0, 1.0),
+		geometry.NewVector2FromXY(0.0, 0.0),
+	}
+	for _, simplifier := range simplifiers {
+		dyn4go.AssertEqual(t, 0, len(simplifier.Simplify([]*geometry.Vector2{})))
+		simplified := simplifier.Simplify(square)
+		dyn4go.AssertEqual(t, 3, len(simplified))
+		dyn4go.AssertTrue(t, geometry.GetWindingFromList(simplified) > 0)
+		for _, v := range simplified {
+			dyn4go.AssertTrue(t, v != square[0] && v != square[2] && v != square[3] && v != square[4])
+		}
+		dyn4go.AssertEqual(t, 3, len(simplifier.Simplify(square[1:4])))
+	}
+}
+
+/**
+ * Tests simplifying nil vertices.
+ */
+func TestSimplifyNil(t *testing.T) {
+	simplifiers := []Simplifier{
+		NewDouglasPeucker(1.0),
+		NewVisvalingamWhyatt(1.0),
+		NewVertexCluster(1.0),
+	}
+	for _, simplifier := range simplifiers {
+		func() {
+			defer dyn4go.AssertPanic(t)
+			simplifier.Simplify(nil)
+		}()
+		func() {
+			defer dyn4go.AssertPanic(t)
+			simplifier.Simplify([]*geometry.Vector2{new(geometry.Vector2), nil})
+		}()
+	}
+}
+
+/**
+ * Tests creating simplifiers with negative tolerances.
+ */
+func TestSimplifyNegativeTolerance(t *testing.T) {
+	func() {
+		defer dyn4go.AssertPanic(t)
+		NewDouglasPeucker(-1.0)
+	}()
+	func() {
+		defer dyn4go.AssertPanic(t)
+		NewVisvalingamWhyatt(-1.0)
+	}()
+	func() {
+		defer dyn4go.AssertPanic(t)
+		NewVertexCluster(-1.0)
+	}()
+}
+
+/**
+ * Benchmarks simplifying a tightly wound outline with a few thousand vertices.
+ */
+func BenchmarkSimplifySpiral(b *testing.B) {
+	r := rand.New(rand.NewSource(3))
+	vertices := createSimplifierTestSpiral(r, 2000, 0.3)
+	simplifiers := map[string]Simplifier{
+		"DouglasPeucker":    NewDouglasPeucker(4.0),
+		"VisvalingamWhyatt": NewVisvalingamWhyatt(4.0),
+		"VertexCluster":     NewVertexCluster(4.0),
+	}
+	for name, simplifier := range simplifiers {
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				simplifier.Simplify(vertices)
+			}
+		})
+	}
+}
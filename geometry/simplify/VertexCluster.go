@@ -0,0 +1,47 @@
+package simplify
+
+import (
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type VertexCluster struct {
+	radius float64
+}
+
+var _ Simplifier = new(VertexCluster)
+
+func NewVertexCluster(radius float64) *VertexCluster {
+	if radius < 0 {
+		panic("Radius must not be negative")
+	}
+	v := new(VertexCluster)
+	v.radius = radius
+	return v
+}
+
+func (v *VertexCluster) GetRadius() float64 {
+	return v.radius
+}
+
+func (v *VertexCluster) Simplify(vertices []*geometry.Vector2) []*geometry.Vector2 {
+	unique := getUniqueVertices(vertices)
+	n := len(unique)
+	if n <= 3 {
+		return copyVertices(unique)
+	}
+	keep := make([]bool, n)
+	keep[0] = true
+	anchor := unique[0]
+	last := 0
+	for i := 1; i < n; i++ {
+		if unique[i].DistanceFromVector2(anchor) > v.radius {
+			keep[i] = true
+			anchor = unique[i]
+			last = i
+		}
+	}
+	if last != 0 && unique[last].DistanceFromVector2(unique[0]) <= v.radius {
+		keep[last] = false
+	}
+	return getSimpleVertices(unique, keep)
+}
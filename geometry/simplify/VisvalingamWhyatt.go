@@ -0,0 +1,68 @@
+package simplify
+
+import (
+	"math"
+
+	"github.com/LSFN/dyn4go/geometry"
+)
+
+type VisvalingamWhyatt struct {
+	minimumArea float64
+}
+
+var _ Simplifier = new(VisvalingamWhyatt)
+
+func NewVisvalingamWhyatt(minimumArea float64) *VisvalingamWhyatt {
+	if minimumArea < 0 {
+		panic("Minimum area must not be negative")
+	}
+	v := new(VisvalingamWhyatt)
+	v.minimumArea = minimumArea
+	return v
+}
+
+func (v *VisvalingamWhyatt) GetMinimumArea() float64 {
+	return v.minimumArea
+}
+
+func (v *VisvalingamWhyatt) Simplify(vertices []*geometry.Vector2) []*geometry.Vector2 {
+	unique := getUniqueVertices(vertices)
+	n := len(unique)
+	if n <= 3 {
+		return copyVertices(unique)
+	}
+	keep := make([]bool, n)
+	prev := make([]int, n)
+	next := make([]int, n)
+	areas := make([]float64, n)
+	for i := range unique {
+		keep[i] = true
+		prev[i] = (i + n - 1) % n
+		next[i] = (i + 1) % n
+	}
+	area := func(i int) float64 {
+		return math.Abs(unique[prev[i]].HereToVector2(unique[i]).CrossVector2(unique[prev[i]].HereToVector2(unique[next[i]]))) * 0.5
+	}
+	for i := range unique {
+		areas[i] = area(i)
+	}
+	for remaining := n; remaining > 3; remaining-- {
+		min := -1
+		for i, k := range keep {
+			if k && (min < 0 || areas[i] < areas[min]) {
+				min = i
+			}
+		}
+		if areas[min] >= v.minimumArea {
+			break
+		}
+		keep[min] = false
+		p := prev[min]
+		q := next[min]
+		next[p] = q
+		prev[q] = p
+		areas[p] = math.Max(area(p), areas[min])
+		areas[q] = math.Max(area(q), areas[min])
+	}
+	return getSimpleVertices(unique, keep)
+}